@@ -0,0 +1,119 @@
+package graph
+
+import "maps"
+
+type edgeKey[T comparable] struct {
+	from T
+	to   T
+}
+
+// SetNodeAttribute stores a value under key for the node and reports whether the node exists.
+func (g *Graph[T, W]) SetNodeAttribute(node T, key string, value any) bool {
+	if _, ok := g.adjacency[node]; !ok {
+		return false
+	}
+	if g.nodeAttributes[node] == nil {
+		g.nodeAttributes[node] = make(map[string]any)
+	}
+	g.nodeAttributes[node][key] = value
+	return true
+}
+
+func (g *Graph[T, W]) NodeAttribute(node T, key string) (any, bool) {
+	value, ok := g.nodeAttributes[node][key]
+	return value, ok
+}
+
+// NodeAttributes returns a copy of every attribute stored for the node.
+func (g *Graph[T, W]) NodeAttributes(node T) map[string]any {
+	attributes := make(map[string]any, len(g.nodeAttributes[node]))
+	maps.Copy(attributes, g.nodeAttributes[node])
+	return attributes
+}
+
+func (g *Graph[T, W]) DeleteNodeAttribute(node T, key string) {
+	delete(g.nodeAttributes[node], key)
+}
+
+// SetEdgeAttribute stores a value under key for the edges from one node to the other and reports
+// whether such an edge exists. Parallel edges share their attributes, as do both directions of an
+// undirected edge.
+func (g *Graph[T, W]) SetEdgeAttribute(from, to T, key string, value any) bool {
+	if !g.HasEdge(from, to) {
+		return false
+	}
+	for _, edge := range g.edgeKeys(from, to) {
+		if g.edgeAttributes[edge] == nil {
+			g.edgeAttributes[edge] = make(map[string]any)
+		}
+		g.edgeAttributes[edge][key] = value
+	}
+	return true
+}
+
+func (g *Graph[T, W]) EdgeAttribute(from, to T, key string) (any, bool) {
+	value, ok := g.edgeAttributes[edgeKey[T]{from: from, to: to}][key]
+	return value, ok
+}
+
+// EdgeAttributes returns a copy of every attribute stored for the edges from one node to the other.
+func (g *Graph[T, W]) EdgeAttributes(from, to T) map[string]any {
+	stored := g.edgeAttributes[edgeKey[T]{from: from, to: to}]
+	attributes := make(map[string]any, len(stored))
+	maps.Copy(attributes, stored)
+	return attributes
+}
+
+func (g *Graph[T, W]) DeleteEdgeAttribute(from, to T, key string) {
+	for _, edge := range g.edgeKeys(from, to) {
+		delete(g.edgeAttributes[edge], key)
+	}
+}
+
+// NodeAttributeOf returns the node attribute stored under key when it holds a value of type V.
+func NodeAttributeOf[V any, T comparable, W Weight](g *Graph[T, W], node T, key string) (V, bool) {
+	value, ok := g.NodeAttribute(node, key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	typed, ok := value.(V)
+	return typed, ok
+}
+
+// EdgeAttributeOf returns the edge attribute stored under key when it holds a value of type V.
+func EdgeAttributeOf[V any, T comparable, W Weight](g *Graph[T, W], from, to T, key string) (V, bool) {
+	value, ok := g.EdgeAttribute(from, to, key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	typed, ok := value.(V)
+	return typed, ok
+}
+
+func (g *Graph[T, W]) edgeKeys(from, to T) []edgeKey[T] {
+	keys := []edgeKey[T]{{from: from, to: to}}
+	if g.undirected && from != to {
+		keys = append(keys, edgeKey[T]{from: to, to: from})
+	}
+	return keys
+}
+
+func (g *Graph[T, W]) copyNodeAttributes(source *Graph[T, W]) {
+	for node := range source.nodeAttributes {
+		g.copyNodeAttribute(source, node)
+	}
+}
+
+func (g *Graph[T, W]) copyNodeAttribute(source *Graph[T, W], node T) {
+	for key, value := range source.nodeAttributes[node] {
+		g.SetNodeAttribute(node, key, value)
+	}
+}
+
+func (g *Graph[T, W]) copyEdgeAttributes(source *Graph[T, W], from, to T) {
+	for key, value := range source.edgeAttributes[edgeKey[T]{from: from, to: to}] {
+		g.SetEdgeAttribute(from, to, key, value)
+	}
+}
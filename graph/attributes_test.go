@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+type colour struct {
+	r, g, b uint8
+}
+
+func TestGraph_NodeAttributes(t *testing.T) {
+	t.Run("missing node rejects attributes", func(t *testing.T) {
+		g := New[string]()
+		assertx.False(t, g.SetNodeAttribute("A", "label", "start"))
+		value, ok := g.NodeAttribute("A", "label")
+		assertx.False(t, ok)
+		assertx.Nil(t, value)
+		assertx.Equal(t, g.NodeAttributes("A"), map[string]any{})
+	})
+	t.Run("attributes are stored, copied and deleted", func(t *testing.T) {
+		g := New[string]()
+		g.AddNode("A")
+		assertx.True(t, g.SetNodeAttribute("A", "label", "start"))
+		assertx.True(t, g.SetNodeAttribute("A", "colour", colour{r: 255}))
+		value, ok := g.NodeAttribute("A", "label")
+		assertx.True(t, ok)
+		assertx.Equal(t, value, any("start"))
+		attributes := g.NodeAttributes("A")
+		attributes["label"] = "changed"
+		label, ok := NodeAttributeOf[string](g, "A", "label")
+		assertx.True(t, ok)
+		assertx.Equal(t, label, "start")
+		c, ok := NodeAttributeOf[colour](g, "A", "colour")
+		assertx.True(t, ok)
+		assertx.Equal(t, c, colour{r: 255})
+		g.DeleteNodeAttribute("A", "label")
+		assertx.Equal(t, g.NodeAttributes("A"), map[string]any{"colour": colour{r: 255}})
+	})
+	t.Run("typed getter rejects other types", func(t *testing.T) {
+		g := New[string]()
+		g.AddNode("A")
+		g.SetNodeAttribute("A", "capacity", 3)
+		capacity, ok := NodeAttributeOf[string](g, "A", "capacity")
+		assertx.False(t, ok)
+		assertx.Equal(t, capacity, "")
+		_, ok = NodeAttributeOf[int](g, "A", "missing")
+		assertx.False(t, ok)
+	})
+	t.Run("deleting a node removes its attributes", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.SetNodeAttribute("A", "label", "start")
+		g.SetEdgeAttribute("A", "B", "label", "road")
+		g.DeleteNode("A")
+		g.AddEdge("A", "B", 1)
+		assertx.Equal(t, g.NodeAttributes("A"), map[string]any{})
+		assertx.Equal(t, g.EdgeAttributes("A", "B"), map[string]any{})
+	})
+}
+
+func TestGraph_EdgeAttributes(t *testing.T) {
+	t.Run("missing edge rejects attributes", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		assertx.False(t, g.SetEdgeAttribute("B", "A", "capacity", 3))
+		_, ok := g.EdgeAttribute("B", "A", "capacity")
+		assertx.False(t, ok)
+	})
+	t.Run("directed edges keep attributes per direction", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "A", 1)
+		assertx.True(t, g.SetEdgeAttribute("A", "B", "capacity", 3))
+		capacity, ok := EdgeAttributeOf[int](g, "A", "B", "capacity")
+		assertx.True(t, ok)
+		assertx.Equal(t, capacity, 3)
+		_, ok = EdgeAttributeOf[int](g, "B", "A", "capacity")
+		assertx.False(t, ok)
+		g.DeleteEdgeAttribute("A", "B", "capacity")
+		assertx.Equal(t, g.EdgeAttributes("A", "B"), map[string]any{})
+	})
+	t.Run("undirected edges share attributes", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.SetEdgeAttribute("B", "A", "colour", "red")
+		value, ok := g.EdgeAttribute("A", "B", "colour")
+		assertx.True(t, ok)
+		assertx.Equal(t, value, any("red"))
+		g.DeleteEdgeAttribute("A", "B", "colour")
+		assertx.Equal(t, g.EdgeAttributes("B", "A"), map[string]any{})
+	})
+	t.Run("removing an edge removes its attributes", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.SetEdgeAttribute("A", "B", "colour", "red")
+		g.RemoveEdge("B", "A")
+		g.AddEdge("A", "B", 1)
+		assertx.Equal(t, g.EdgeAttributes("A", "B"), map[string]any{})
+		assertx.Equal(t, g.EdgeAttributes("B", "A"), map[string]any{})
+	})
+	t.Run("replaced edges keep attributes", func(t *testing.T) {
+		g := New[string](WithEdgePolicy(ReplaceParallelEdges))
+		g.AddEdge("A", "B", 1)
+		g.SetEdgeAttribute("A", "B", "colour", "red")
+		g.AddEdge("A", "B", 2)
+		assertx.Equal(t, g.EdgeAttributes("A", "B"), map[string]any{"colour": "red"})
+	})
+}
+
+func TestGraph_Clone(t *testing.T) {
+	g := New[string](WithEdgePolicy(RejectParallelEdges))
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("B", "C", 2)
+	g.SetNodeAttribute("A", "label", "start")
+	g.SetEdgeAttribute("A", "B", "colour", "red")
+	clone := g.Clone()
+	assertx.Equal(t, clone.String(), g.String())
+	assertx.ErrorIs(t, clone.AddEdge("A", "B", 5), ErrEdgeExists)
+	clone.AddEdge("C", "D", 3)
+	clone.SetWeight("A", "B", 9)
+	clone.SetNodeAttribute("A", "label", "changed")
+	clone.SetEdgeAttribute("A", "B", "colour", "blue")
+	assertx.Equal(t, g.String(), "A -> B (1)"+"\n"+"B -> C (2)"+"\n"+"C")
+	assertx.Equal(t, g.NodeAttributes("A"), map[string]any{"label": "start"})
+	assertx.Equal(t, g.EdgeAttributes("A", "B"), map[string]any{"colour": "red"})
+	assertx.Equal(t, clone.NodeAttributes("A"), map[string]any{"label": "changed"})
+}
+
+func TestGraph_AsUndirectedAttributes(t *testing.T) {
+	g := New[string]()
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("B", "A", 2)
+	g.SetNodeAttribute("B", "label", "end")
+	g.SetEdgeAttribute("A", "B", "colour", "red")
+	g.SetEdgeAttribute("B", "A", "colour", "blue")
+	undirected := g.AsUndirected()
+	assertx.Equal(t, undirected.NodeAttributes("B"), map[string]any{"label": "end"})
+	assertx.Equal(t, undirected.EdgeAttributes("B", "A"), map[string]any{"colour": "red"})
+}
@@ -0,0 +1,191 @@
+package graph
+
+import "slices"
+
+// Bipartition splits the nodes into two sides with every edge crossing between them.
+// When the graph is not bipartite the sides are empty and OddCycle proves it.
+type Bipartition[T comparable] struct {
+	Left     []T
+	Right    []T
+	OddCycle []T
+}
+
+// IsBipartite two colours the graph ignoring edge direction.
+func (g *Graph[T, W]) IsBipartite() (*Bipartition[T], bool) {
+	neighbours := g.undirectedNeighbours()
+	colour := make(map[T]bool, g.Len())
+	parent := make(map[T]T, g.Len())
+	nodes := g.Nodes()
+	for _, root := range nodes {
+		if _, ok := colour[root]; ok {
+			continue
+		}
+		colour[root] = false
+		queue := []T{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			for _, link := range neighbours[node] {
+				linkColour, ok := colour[link]
+				if !ok {
+					colour[link] = !colour[node]
+					parent[link] = node
+					queue = append(queue, link)
+				} else if linkColour == colour[node] {
+					return &Bipartition[T]{Left: []T{}, Right: []T{}, OddCycle: oddCycle(parent, node, link)}, false
+				}
+			}
+		}
+	}
+	bipartition := &Bipartition[T]{Left: []T{}, Right: []T{}}
+	for _, node := range nodes {
+		if colour[node] {
+			bipartition.Right = append(bipartition.Right, node)
+		} else {
+			bipartition.Left = append(bipartition.Left, node)
+		}
+	}
+	return bipartition, true
+}
+
+// oddCycle joins the tree paths of two equally coloured neighbours at their closest common ancestor.
+func oddCycle[T comparable](parent map[T]T, a, b T) []T {
+	ancestors := []T{a}
+	for {
+		next, ok := parent[ancestors[len(ancestors)-1]]
+		if !ok {
+			break
+		}
+		ancestors = append(ancestors, next)
+	}
+	path := []T{}
+	for node := b; ; node = parent[node] {
+		if index := slices.Index(ancestors, node); index >= 0 {
+			cycle := slices.Clone(ancestors[:index+1])
+			slices.Reverse(path)
+			return append(cycle, path...)
+		}
+		path = append(path, node)
+	}
+}
+
+// undirectedNeighbours merges incoming and outgoing edges so direction can be ignored.
+func (g *Graph[T, W]) undirectedNeighbours() map[T][]T {
+	neighbours := make(map[T][]T, g.Len())
+	for _, node := range g.Nodes() {
+		for _, edge := range g.adjacency[node] {
+			neighbours[node] = append(neighbours[node], edge.Link)
+			if !g.undirected && edge.Link != node {
+				neighbours[edge.Link] = append(neighbours[edge.Link], node)
+			}
+		}
+	}
+	return neighbours
+}
+
+// MaximumMatching uses Hopcroft-Karp on the bipartition found by IsBipartite and returns the matched
+// edges oriented as they were added. An *OddCycleError is returned when the graph is not bipartite.
+func (g *Graph[T, W]) MaximumMatching() ([]Arc[T, W], error) {
+	bipartition, ok := g.IsBipartite()
+	if !ok {
+		return nil, &OddCycleError[T]{Cycle: bipartition.OddCycle}
+	}
+	left, right := bipartition.Left, bipartition.Right
+	rightIndex := positions(right)
+	neighbours := g.undirectedNeighbours()
+	links := make([][]int, len(left))
+	for i, node := range left {
+		for _, link := range neighbours[node] {
+			links[i] = append(links[i], rightIndex[link])
+		}
+	}
+	matching := newBipartiteMatching(links, len(right))
+	matching.run()
+	pairs := []Arc[T, W]{}
+	for i, match := range matching.matchLeft {
+		if match < 0 {
+			continue
+		}
+		from, to := left[i], right[match]
+		edge, ok := g.Edge(from, to)
+		if !ok {
+			from, to = to, from
+			edge, _ = g.Edge(from, to)
+		}
+		pairs = append(pairs, Arc[T, W]{From: from, To: to, Weight: edge.Weight})
+	}
+	return pairs, nil
+}
+
+type bipartiteMatching struct {
+	links      [][]int
+	matchLeft  []int
+	matchRight []int
+	distance   []int
+}
+
+func newBipartiteMatching(links [][]int, rightSize int) *bipartiteMatching {
+	m := &bipartiteMatching{
+		links:      links,
+		matchLeft:  make([]int, len(links)),
+		matchRight: make([]int, rightSize),
+		distance:   make([]int, len(links)),
+	}
+	for i := range m.matchLeft {
+		m.matchLeft[i] = -1
+	}
+	for i := range m.matchRight {
+		m.matchRight[i] = -1
+	}
+	return m
+}
+
+func (m *bipartiteMatching) run() {
+	for m.layer() {
+		for i := range m.links {
+			if m.matchLeft[i] < 0 {
+				m.augment(i)
+			}
+		}
+	}
+}
+
+// layer runs the breadth first phase from every free left node and reports whether a free right node is reachable.
+func (m *bipartiteMatching) layer() bool {
+	queue := []int{}
+	for i := range m.links {
+		m.distance[i] = -1
+		if m.matchLeft[i] < 0 {
+			m.distance[i] = 0
+			queue = append(queue, i)
+		}
+	}
+	found := false
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, link := range m.links[node] {
+			match := m.matchRight[link]
+			if match < 0 {
+				found = true
+			} else if m.distance[match] < 0 {
+				m.distance[match] = m.distance[node] + 1
+				queue = append(queue, match)
+			}
+		}
+	}
+	return found
+}
+
+func (m *bipartiteMatching) augment(node int) bool {
+	for _, link := range m.links[node] {
+		match := m.matchRight[link]
+		if match < 0 || (m.distance[match] == m.distance[node]+1 && m.augment(match)) {
+			m.matchLeft[node] = link
+			m.matchRight[link] = node
+			return true
+		}
+	}
+	m.distance[node] = -1
+	return false
+}
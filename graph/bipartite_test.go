@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func TestGraph_IsBipartite(t *testing.T) {
+	t.Run("empty graph is bipartite", func(t *testing.T) {
+		g := New[string]()
+		bipartition, ok := g.IsBipartite()
+		assertx.True(t, ok)
+		assertx.Equal(t, bipartition, &Bipartition[string]{Left: []string{}, Right: []string{}})
+	})
+	t.Run("even cycle and isolated nodes are bipartite", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("C", "B", 0)
+		g.AddEdge("C", "D", 0)
+		g.AddEdge("D", "A", 0)
+		g.AddNode("E")
+		bipartition, ok := g.IsBipartite()
+		assertx.True(t, ok)
+		assertx.Equal(t, bipartition.Left, []string{"A", "C", "E"})
+		assertx.Equal(t, bipartition.Right, []string{"B", "D"})
+		assertx.Nil(t, bipartition.OddCycle)
+	})
+	t.Run("odd cycle is returned as proof", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("B", "C", 0)
+		g.AddEdge("C", "D", 0)
+		g.AddEdge("D", "E", 0)
+		g.AddEdge("E", "A", 0)
+		bipartition, ok := g.IsBipartite()
+		assertx.False(t, ok)
+		assertx.Equal(t, len(bipartition.OddCycle), 5)
+		assertx.True(t, isCycle(g, bipartition.OddCycle))
+	})
+	t.Run("self loop is an odd cycle", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("B", "B", 0)
+		bipartition, ok := g.IsBipartite()
+		assertx.False(t, ok)
+		assertx.Equal(t, bipartition.OddCycle, []string{"B"})
+	})
+}
+
+func TestGraph_MaximumMatching(t *testing.T) {
+	t.Run("non bipartite graph yields typed error", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("B", "C", 0)
+		g.AddEdge("C", "A", 0)
+		pairs, err := g.MaximumMatching()
+		assertx.Nil(t, pairs)
+		var cycleErr *OddCycleError[string]
+		assertx.True(t, errors.As(err, &cycleErr))
+		assertx.Equal(t, len(cycleErr.Cycle), 3)
+		assertx.Equal(t, err.Error(), "graph is not bipartite, odd cycle: "+formatCycle(cycleErr.Cycle))
+	})
+	t.Run("workers are matched to jobs", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("alice", "build", 1)
+		g.AddEdge("alice", "deploy", 2)
+		g.AddEdge("bob", "build", 3)
+		g.AddEdge("carol", "build", 4)
+		g.AddEdge("carol", "test", 5)
+		g.AddEdge("dave", "test", 6)
+		pairs, err := g.MaximumMatching()
+		assertx.Nil(t, err)
+		assertx.Equal(t, len(pairs), 3)
+		matched := make(map[string]bool)
+		for _, pair := range pairs {
+			assertx.True(t, g.HasEdge(pair.From, pair.To))
+			edge, _ := g.Edge(pair.From, pair.To)
+			assertx.Equal(t, pair.Weight, edge.Weight)
+			assertx.False(t, matched[pair.From])
+			assertx.False(t, matched[pair.To])
+			matched[pair.From] = true
+			matched[pair.To] = true
+		}
+		assertx.True(t, matched["deploy"])
+		assertx.True(t, matched["build"])
+		assertx.True(t, matched["test"])
+	})
+	t.Run("augmenting paths improve greedy matches", func(t *testing.T) {
+		g := New[int](WithUndirected())
+		g.AddEdge(1, 10, 0)
+		g.AddEdge(1, 11, 0)
+		g.AddEdge(2, 10, 0)
+		g.AddEdge(3, 11, 0)
+		g.AddEdge(3, 12, 0)
+		g.AddEdge(4, 12, 0)
+		pairs, err := g.MaximumMatching()
+		assertx.Nil(t, err)
+		assertx.Equal(t, len(pairs), 3)
+	})
+}
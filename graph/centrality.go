@@ -0,0 +1,214 @@
+package graph
+
+import "math"
+
+// InDegree counts the edges arriving at every node, undirected edges arrive at both endpoints so an
+// undirected self loop counts twice.
+func (g *Graph[T, W]) InDegree() map[T]int {
+	degrees := make(map[T]int, g.Len())
+	for node := range g.adjacency {
+		degrees[node] = 0
+	}
+	for node, edges := range g.adjacency {
+		for _, edge := range edges {
+			degrees[edge.Link]++
+			if g.undirected && edge.Link == node {
+				degrees[node]++
+			}
+		}
+	}
+	return degrees
+}
+
+// OutDegree counts the edges leaving every node, undirected edges leave from both endpoints so an
+// undirected self loop counts twice.
+func (g *Graph[T, W]) OutDegree() map[T]int {
+	degrees := make(map[T]int, g.Len())
+	for node, edges := range g.adjacency {
+		degrees[node] = len(edges)
+		if !g.undirected {
+			continue
+		}
+		for _, edge := range edges {
+			if edge.Link == node {
+				degrees[node]++
+			}
+		}
+	}
+	return degrees
+}
+
+type pageRankConfig struct {
+	damping       float64
+	tolerance     float64
+	maxIterations int
+}
+
+type PageRankOption func(*pageRankConfig)
+
+// WithDamping sets the probability of following an edge instead of jumping to a random node, default 0.85.
+func WithDamping(damping float64) PageRankOption {
+	return func(c *pageRankConfig) {
+		c.damping = damping
+	}
+}
+
+// WithTolerance stops iterating once the ranks change by less than tolerance in total, default 1e-6.
+func WithTolerance(tolerance float64) PageRankOption {
+	return func(c *pageRankConfig) {
+		c.tolerance = tolerance
+	}
+}
+
+// WithMaxIterations caps the number of power iterations, default 100.
+func WithMaxIterations(iterations int) PageRankOption {
+	return func(c *pageRankConfig) {
+		c.maxIterations = iterations
+	}
+}
+
+// PageRank scores nodes by the stationary distribution of a random walk along the edges, the scores sum
+// to one. Weights are ignored, parallel edges count once and nodes without edges spread their rank evenly.
+func (g *Graph[T, W]) PageRank(options ...PageRankOption) map[T]float64 {
+	config := &pageRankConfig{damping: 0.85, tolerance: 1e-6, maxIterations: 100}
+	for _, option := range options {
+		option(config)
+	}
+	nodes := g.Nodes()
+	size := len(nodes)
+	neighbours := g.indexedNeighbours(nodes)
+	rank := make([]float64, size)
+	for i := range rank {
+		rank[i] = 1 / float64(size)
+	}
+	for range config.maxIterations {
+		dangling := 0.0
+		for i, links := range neighbours {
+			if len(links) == 0 {
+				dangling += rank[i]
+			}
+		}
+		next := make([]float64, size)
+		for i := range next {
+			next[i] = (1-config.damping)/float64(size) + config.damping*dangling/float64(size)
+		}
+		for i, links := range neighbours {
+			for _, link := range links {
+				next[link] += config.damping * rank[i] / float64(len(links))
+			}
+		}
+		change := 0.0
+		for i := range rank {
+			change += math.Abs(next[i] - rank[i])
+		}
+		rank = next
+		if change < config.tolerance {
+			break
+		}
+	}
+	scores := make(map[T]float64, size)
+	for i, node := range nodes {
+		scores[node] = rank[i]
+	}
+	return scores
+}
+
+// Betweenness uses Brandes' algorithm to sum, for every node, the fraction of shortest paths between
+// other pairs of nodes that pass through it. Paths are counted in hops, undirected pairs count once.
+func (g *Graph[T, W]) Betweenness() map[T]float64 {
+	nodes := g.Nodes()
+	neighbours := g.indexedNeighbours(nodes)
+	centrality := make([]float64, len(nodes))
+	for source := range nodes {
+		distance, order := hopDistances(neighbours, source)
+		paths := make([]float64, len(nodes))
+		paths[source] = 1
+		for _, node := range order {
+			for _, link := range neighbours[node] {
+				if distance[link] == distance[node]+1 {
+					paths[link] += paths[node]
+				}
+			}
+		}
+		dependency := make([]float64, len(nodes))
+		for i := len(order) - 1; i >= 0; i-- {
+			node := order[i]
+			for _, link := range neighbours[node] {
+				if distance[link] == distance[node]+1 {
+					dependency[node] += paths[node] / paths[link] * (1 + dependency[link])
+				}
+			}
+			if node != source {
+				centrality[node] += dependency[node]
+			}
+		}
+	}
+	scores := make(map[T]float64, len(nodes))
+	for i, node := range nodes {
+		if g.undirected {
+			centrality[i] /= 2
+		}
+		scores[node] = centrality[i]
+	}
+	return scores
+}
+
+// Closeness scores every node by the inverse of its average hop distance to the nodes it can reach,
+// scaled by the fraction of nodes it reaches so disconnected graphs compare fairly.
+func (g *Graph[T, W]) Closeness() map[T]float64 {
+	nodes := g.Nodes()
+	neighbours := g.indexedNeighbours(nodes)
+	scores := make(map[T]float64, len(nodes))
+	for source, node := range nodes {
+		distance, order := hopDistances(neighbours, source)
+		total := 0
+		for _, reached := range order {
+			total += distance[reached]
+		}
+		reached := float64(len(order) - 1)
+		if total == 0 {
+			scores[node] = 0
+			continue
+		}
+		scores[node] = reached / float64(total) * reached / float64(len(nodes)-1)
+	}
+	return scores
+}
+
+// indexedNeighbours maps every node to the positions of its distinct neighbours in nodes.
+func (g *Graph[T, W]) indexedNeighbours(nodes []T) [][]int {
+	position := positions(nodes)
+	neighbours := make([][]int, len(nodes))
+	for i, node := range nodes {
+		seen := make(map[int]bool)
+		for _, edge := range g.adjacency[node] {
+			link := position[edge.Link]
+			if !seen[link] {
+				seen[link] = true
+				neighbours[i] = append(neighbours[i], link)
+			}
+		}
+	}
+	return neighbours
+}
+
+// hopDistances runs a breadth first search returning hop distances, -1 when unreachable, and the
+// nodes reached in the order they were found.
+func hopDistances(neighbours [][]int, source int) ([]int, []int) {
+	distance := make([]int, len(neighbours))
+	for i := range distance {
+		distance[i] = -1
+	}
+	distance[source] = 0
+	order := []int{source}
+	for i := 0; i < len(order); i++ {
+		node := order[i]
+		for _, link := range neighbours[node] {
+			if distance[link] < 0 {
+				distance[link] = distance[node] + 1
+				order = append(order, link)
+			}
+		}
+	}
+	return distance, order
+}
@@ -0,0 +1,156 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func assertScores[T comparable](t *testing.T, got, want map[T]float64) {
+	t.Helper()
+	assertx.Equal(t, len(got), len(want))
+	for node, score := range want {
+		if math.Abs(got[node]-score) > 1e-4 {
+			t.Errorf("score of %v: got: %v, want: %v", node, got[node], score)
+		}
+	}
+}
+
+func TestGraph_Degree(t *testing.T) {
+	t.Run("directed", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", 1)
+		g.AddEdge("B", "C", 1)
+		g.AddNode("D")
+		assertx.Equal(t, g.InDegree(), map[string]int{"A": 0, "B": 1, "C": 2, "D": 0})
+		assertx.Equal(t, g.OutDegree(), map[string]int{"A": 2, "B": 1, "C": 0, "D": 0})
+	})
+	t.Run("undirected", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", 1)
+		assertx.Equal(t, g.InDegree(), map[string]int{"A": 2, "B": 1, "C": 1})
+		assertx.Equal(t, g.OutDegree(), map[string]int{"A": 2, "B": 1, "C": 1})
+	})
+	t.Run("self loops", func(t *testing.T) {
+		g := New[int](WithUndirected())
+		g.AddEdge(1, 1, 1)
+		g.AddEdge(1, 2, 1)
+		assertx.Equal(t, g.InDegree(), map[int]int{1: 3, 2: 1})
+		assertx.Equal(t, g.OutDegree(), map[int]int{1: 3, 2: 1})
+		d := New[int]()
+		d.AddEdge(1, 1, 1)
+		d.AddEdge(1, 2, 1)
+		assertx.Equal(t, d.InDegree(), map[int]int{1: 1, 2: 1})
+		assertx.Equal(t, d.OutDegree(), map[int]int{1: 2, 2: 0})
+	})
+}
+
+func TestGraph_PageRank(t *testing.T) {
+	t.Run("empty graph has no scores", func(t *testing.T) {
+		g := New[string]()
+		assertx.Equal(t, g.PageRank(), map[string]float64{})
+	})
+	t.Run("cycle ranks nodes equally", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 1)
+		g.AddEdge("C", "A", 1)
+		assertScores(t, g.PageRank(), map[string]float64{"A": 1.0 / 3, "B": 1.0 / 3, "C": 1.0 / 3})
+	})
+	t.Run("dangling node spreads its rank", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "B", 1)
+		assertScores(t, g.PageRank(), map[string]float64{"A": 0.350877, "B": 0.649123})
+	})
+	t.Run("damping and iterations are configurable", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		assertScores(t, g.PageRank(WithDamping(0)), map[string]float64{"A": 0.5, "B": 0.5})
+		assertScores(t, g.PageRank(WithMaxIterations(1)), map[string]float64{"A": 0.2875, "B": 0.7125})
+		assertScores(t, g.PageRank(WithTolerance(1)), map[string]float64{"A": 0.2875, "B": 0.7125})
+	})
+	t.Run("hub collects the most rank", func(t *testing.T) {
+		g := New[string]()
+		for _, leaf := range []string{"A", "B", "C", "D"} {
+			g.AddEdge(leaf, "hub", 1)
+		}
+		g.AddEdge("hub", "A", 1)
+		scores := g.PageRank()
+		total := 0.0
+		for node, score := range scores {
+			total += score
+			if node != "hub" {
+				assertx.True(t, score < scores["hub"])
+			}
+		}
+		assertx.True(t, math.Abs(total-1) < 1e-9)
+	})
+}
+
+func TestGraph_Betweenness(t *testing.T) {
+	t.Run("undirected path", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 1)
+		g.AddEdge("C", "D", 1)
+		assertScores(t, g.Betweenness(), map[string]float64{"A": 0, "B": 2, "C": 2, "D": 0})
+	})
+	t.Run("directed diamond splits paths", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", 1)
+		g.AddEdge("B", "D", 1)
+		g.AddEdge("C", "D", 1)
+		g.AddEdge("C", "D", 1)
+		g.AddEdge("D", "D", 1)
+		assertScores(t, g.Betweenness(), map[string]float64{"A": 0, "B": 0.5, "C": 0.5, "D": 0})
+	})
+	t.Run("weights are ignored", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 1)
+		g.AddEdge("A", "C", 10)
+		assertScores(t, g.Betweenness(), map[string]float64{"A": 0, "B": 0, "C": 0})
+	})
+}
+
+func TestGraph_Closeness(t *testing.T) {
+	t.Run("undirected path", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 1)
+		assertScores(t, g.Closeness(), map[string]float64{"A": 2.0 / 3, "B": 1, "C": 2.0 / 3})
+	})
+	t.Run("directed graph uses outgoing distances", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 1)
+		g.AddNode("D")
+		assertScores(t, g.Closeness(), map[string]float64{"A": 2.0 / 3 * 2.0 / 3, "B": 1.0 / 3, "C": 0, "D": 0})
+	})
+	t.Run("single node scores zero", func(t *testing.T) {
+		g := New[string]()
+		g.AddNode("A")
+		assertScores(t, g.Closeness(), map[string]float64{"A": 0})
+	})
+}
+
+func BenchmarkPageRank_100(b *testing.B) {
+	g, _, _ := createGridGraph(100)
+	b.ResetTimer()
+	for b.Loop() {
+		g.PageRank()
+	}
+}
+
+func BenchmarkBetweenness_30(b *testing.B) {
+	g, _, _ := createGridGraph(30)
+	b.ResetTimer()
+	for b.Loop() {
+		g.Betweenness()
+	}
+}
@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Reachable reports whether to can be reached from from by following edges, a node reaches itself.
+func (g *Graph[T, W]) Reachable(from, to T) bool {
+	if _, ok := g.adjacency[to]; !ok {
+		return false
+	}
+	for visit := range g.DFSSeq(from) {
+		if visit.Node == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitiveClosure returns a graph with an edge from every node to each node reachable from it through
+// at least one edge, so nodes on a cycle get a self loop. Edges already in the graph keep their weight
+// and attributes, the others have a zero weight.
+func (g *Graph[T, W]) TransitiveClosure() (*Graph[T, W], error) {
+	if g.undirected {
+		return nil, fmt.Errorf("%w: transitive closure requires a directed graph", ErrUndirected)
+	}
+	closure := g.emptyCopy()
+	nodes := g.Nodes()
+	for _, node := range nodes {
+		closure.AddNode(node)
+	}
+	for _, node := range nodes {
+		reached := make(map[T]bool)
+		for _, edge := range g.adjacency[node] {
+			for visit := range g.DFSSeq(edge.Link, WithPrune(func(visit Visit[T]) bool {
+				return reached[visit.Node]
+			})) {
+				reached[visit.Node] = true
+			}
+		}
+		for _, link := range nodes {
+			if reached[link] {
+				closure.addReducedEdge(g, node, link)
+			}
+		}
+	}
+	closure.copyNodeAttributes(g)
+	return closure, nil
+}
+
+// TransitiveReduction returns the smallest graph with the same reachability as the acyclic graph, kept
+// edges carry the weight and attributes of the first edge between their nodes. Undirected graphs and
+// graphs with a cycle yield the same errors as TopologicalSort.
+func (g *Graph[T, W]) TransitiveReduction() (*Graph[T, W], error) {
+	order, err := g.TopologicalSort()
+	if err != nil {
+		return nil, err
+	}
+	position := positions(order)
+	words := (len(order) + 63) / 64
+	descendants := make([][]uint64, len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		descendants[i] = make([]uint64, words)
+		for _, edge := range g.adjacency[order[i]] {
+			link := position[edge.Link]
+			descendants[i][link/64] |= 1 << (link % 64)
+			for word, value := range descendants[link] {
+				descendants[i][word] |= value
+			}
+		}
+	}
+	reduction := g.emptyCopy()
+	for _, node := range g.Nodes() {
+		reduction.AddNode(node)
+	}
+	for _, node := range g.Nodes() {
+		successors := make([]int, 0, len(g.adjacency[node]))
+		for _, edge := range g.adjacency[node] {
+			successors = append(successors, position[edge.Link])
+		}
+		slices.Sort(successors)
+		reached := make([]uint64, words)
+		kept := make(map[T]bool)
+		for _, link := range slices.Compact(successors) {
+			if reached[link/64]&(1<<(link%64)) != 0 {
+				continue
+			}
+			kept[order[link]] = true
+			reached[link/64] |= 1 << (link % 64)
+			for word, value := range descendants[link] {
+				reached[word] |= value
+			}
+		}
+		for _, edge := range g.adjacency[node] {
+			if kept[edge.Link] {
+				reduction.addReducedEdge(g, node, edge.Link)
+				delete(kept, edge.Link)
+			}
+		}
+	}
+	reduction.copyNodeAttributes(g)
+	return reduction, nil
+}
+
+// addReducedEdge adds a single edge from one node to the other using the first such edge of source when
+// it exists.
+func (g *Graph[T, W]) addReducedEdge(source *Graph[T, W], from, to T) {
+	edge, _ := source.Edge(from, to)
+	g.AddEdge(from, to, edge.Weight)
+	g.copyEdgeAttributes(source, from, to)
+}
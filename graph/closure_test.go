@@ -0,0 +1,118 @@
+package graph
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func TestGraph_Reachable(t *testing.T) {
+	g := New[string]()
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("B", "C", 1)
+	g.AddNode("D")
+	assertx.True(t, g.Reachable("A", "C"))
+	assertx.True(t, g.Reachable("D", "D"))
+	assertx.False(t, g.Reachable("C", "A"))
+	assertx.False(t, g.Reachable("A", "D"))
+	assertx.False(t, g.Reachable("A", "E"))
+	assertx.False(t, g.Reachable("E", "A"))
+	assertx.True(t, g.AsUndirected().Reachable("C", "A"))
+}
+
+func TestGraph_TransitiveClosure(t *testing.T) {
+	t.Run("undirected graph yields error", func(t *testing.T) {
+		closure, err := New[string](WithUndirected()).TransitiveClosure()
+		assertx.ErrorIs(t, err, ErrUndirected)
+		assertx.Nil(t, closure)
+	})
+	t.Run("chain gains shortcut edges", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 2)
+		g.AddEdge("B", "C", 3)
+		g.AddNode("D")
+		g.SetNodeAttribute("A", "label", "start")
+		g.SetEdgeAttribute("A", "B", "colour", "red")
+		closure, err := g.TransitiveClosure()
+		assertx.Nil(t, err)
+		assertx.Equal(t, closure.String(), "A -> B (2), C (0)"+"\n"+"B -> C (3)"+"\n"+"C"+"\n"+"D")
+		assertx.Equal(t, closure.NodeAttributes("A"), map[string]any{"label": "start"})
+		assertx.Equal(t, closure.EdgeAttributes("A", "B"), map[string]any{"colour": "red"})
+	})
+	t.Run("cycle gives self loops", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "A", 1)
+		g.AddEdge("B", "C", 1)
+		closure, err := g.TransitiveClosure()
+		assertx.Nil(t, err)
+		assertx.Equal(t, closure.String(), "A -> A (0), B (1), C (0)"+"\n"+"B -> A (1), B (0), C (1)"+"\n"+"C")
+	})
+}
+
+func TestGraph_TransitiveReduction(t *testing.T) {
+	t.Run("undirected graph yields error", func(t *testing.T) {
+		reduction, err := New[string](WithUndirected()).TransitiveReduction()
+		assertx.ErrorIs(t, err, ErrUndirected)
+		assertx.Nil(t, reduction)
+	})
+	t.Run("cycle yields typed error", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "A", 1)
+		reduction, err := g.TransitiveReduction()
+		var cycleErr *CycleError[string]
+		assertx.True(t, errors.As(err, &cycleErr))
+		assertx.Nil(t, reduction)
+	})
+	t.Run("redundant and parallel edges are removed", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "B", 7)
+		g.AddEdge("B", "C", 2)
+		g.AddEdge("A", "C", 3)
+		g.AddEdge("A", "D", 4)
+		g.AddEdge("D", "C", 5)
+		g.AddNode("E")
+		g.SetEdgeAttribute("D", "C", "colour", "red")
+		reduction, err := g.TransitiveReduction()
+		assertx.Nil(t, err)
+		assertx.Equal(t, reduction.String(), "A -> B (1), D (4)"+"\n"+"B -> C (2)"+"\n"+"C"+"\n"+"D -> C (5)"+"\n"+"E")
+		assertx.Equal(t, reduction.EdgeAttributes("D", "C"), map[string]any{"colour": "red"})
+	})
+	t.Run("reduction keeps reachability of a random DAG", func(t *testing.T) {
+		random := rand.New(rand.NewPCG(1, 2))
+		g := New[int]()
+		for i := range 150 {
+			g.AddNode(i)
+			for j := range i {
+				if random.IntN(10) == 0 {
+					g.AddEdge(j, i, 1)
+				}
+			}
+		}
+		reduction, err := g.TransitiveReduction()
+		assertx.Nil(t, err)
+		closure, _ := g.TransitiveClosure()
+		reducedClosure, _ := reduction.TransitiveClosure()
+		assertx.Equal(t, len(reducedClosure.Edges()), len(closure.Edges()))
+		for _, edge := range closure.Edges() {
+			assertx.True(t, reducedClosure.HasEdge(edge.From, edge.To))
+		}
+		for _, edge := range reduction.Edges() {
+			reduction.RemoveEdge(edge.From, edge.To)
+			assertx.False(t, reduction.Reachable(edge.From, edge.To))
+			reduction.AddEdge(edge.From, edge.To, edge.Weight)
+		}
+	})
+}
+
+func BenchmarkTransitiveReduction_100(b *testing.B) {
+	g, _, _ := createGridGraph(100)
+	b.ResetTimer()
+	for b.Loop() {
+		g.TransitiveReduction()
+	}
+}
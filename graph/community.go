@@ -0,0 +1,284 @@
+package graph
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+)
+
+// Communities assigns every node a community numbered from zero in the order of Nodes.
+type Communities[T comparable] struct {
+	Membership map[T]int
+	Count      int
+	Modularity float64
+}
+
+type communityConfig struct {
+	random    *rand.Rand
+	maxSweeps int
+}
+
+type CommunityOption func(*communityConfig)
+
+// WithSeed makes community detection reproducible by seeding its random choices.
+func WithSeed(seed uint64) CommunityOption {
+	return func(c *communityConfig) {
+		c.random = rand.New(rand.NewPCG(seed, seed))
+	}
+}
+
+// WithMaxSweeps caps how many times label propagation, and every level of Louvain, moves through all
+// nodes before returning the communities found so far, default 100.
+func WithMaxSweeps(sweeps int) CommunityOption {
+	return func(c *communityConfig) {
+		c.maxSweeps = sweeps
+	}
+}
+
+func newCommunityConfig(options []CommunityOption) *communityConfig {
+	config := &communityConfig{maxSweeps: 100}
+	for _, option := range options {
+		option(config)
+	}
+	if config.random == nil {
+		config.random = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+	return config
+}
+
+// communityLink is a weighted link of the symmetric network community detection works on.
+type communityLink struct {
+	to     int
+	weight float64
+}
+
+// communityNetwork ignores edge direction and merges parallel edges, a self loop is stored with
+// twice its weight so every node's degree is the sum of its links.
+type communityNetwork struct {
+	links  [][]communityLink
+	degree []float64
+	total  float64
+}
+
+func newCommunityNetwork[T comparable, W Weight](g *Graph[T, W], nodes []T) *communityNetwork {
+	position := positions(nodes)
+	weights := make([]map[int]float64, len(nodes))
+	for i := range weights {
+		weights[i] = make(map[int]float64)
+	}
+	for _, edge := range g.Edges() {
+		from, to := position[edge.From], position[edge.To]
+		weights[from][to] += float64(edge.Weight)
+		weights[to][from] += float64(edge.Weight)
+	}
+	return buildCommunityNetwork(weights)
+}
+
+func buildCommunityNetwork(weights []map[int]float64) *communityNetwork {
+	network := &communityNetwork{
+		links:  make([][]communityLink, len(weights)),
+		degree: make([]float64, len(weights)),
+	}
+	for i, links := range weights {
+		for _, to := range slices.Sorted(maps.Keys(links)) {
+			network.links[i] = append(network.links[i], communityLink{to: to, weight: links[to]})
+			network.degree[i] += links[to]
+		}
+		network.total += network.degree[i]
+	}
+	return network
+}
+
+// modularity compares the weight inside each community with the weight expected at random, summing in
+// community order so equal memberships give bit for bit equal scores.
+func (n *communityNetwork) modularity(community []int) float64 {
+	if n.total == 0 {
+		return 0
+	}
+	community, count := renumber(community)
+	inside := make([]float64, count)
+	degree := make([]float64, count)
+	for i, links := range n.links {
+		degree[community[i]] += n.degree[i]
+		for _, link := range links {
+			if community[link.to] == community[i] {
+				inside[community[i]] += link.weight
+			}
+		}
+	}
+	modularity := 0.0
+	for c, total := range degree {
+		modularity += inside[c]/n.total - (total/n.total)*(total/n.total)
+	}
+	return modularity
+}
+
+// neighbourCommunities sums the weight from node to each neighbouring community in the order the
+// communities are first met.
+func (n *communityNetwork) neighbourCommunities(node int, community []int) ([]int, map[int]float64) {
+	order := []int{}
+	weights := make(map[int]float64)
+	for _, link := range n.links[node] {
+		if link.to == node {
+			continue
+		}
+		c := community[link.to]
+		if _, ok := weights[c]; !ok {
+			order = append(order, c)
+		}
+		weights[c] += link.weight
+	}
+	return order, weights
+}
+
+// Modularity scores how well membership splits the graph, nodes missing from membership form their
+// own communities. Edge direction is ignored and weights should be positive.
+func (g *Graph[T, W]) Modularity(membership map[T]int) float64 {
+	nodes := g.Nodes()
+	community := make([]int, len(nodes))
+	for i, node := range nodes {
+		c, ok := membership[node]
+		if !ok {
+			c = -1 - i
+		}
+		community[i] = c
+	}
+	return newCommunityNetwork(g, nodes).modularity(community)
+}
+
+// LabelPropagation repeatedly moves every node, in random order, to the label carrying the most edge
+// weight among its neighbours until every node holds such a label or WithMaxSweeps is reached. It is fast
+// but results vary by seed and weights should be positive.
+func (g *Graph[T, W]) LabelPropagation(options ...CommunityOption) *Communities[T] {
+	config := newCommunityConfig(options)
+	nodes := g.Nodes()
+	network := newCommunityNetwork(g, nodes)
+	labels := make([]int, len(nodes))
+	for i := range labels {
+		labels[i] = i
+	}
+	for sweep, changed := 0, true; changed && sweep < config.maxSweeps; sweep++ {
+		changed = false
+		for _, node := range config.random.Perm(len(nodes)) {
+			order, weights := network.neighbourCommunities(node, labels)
+			if len(order) == 0 {
+				continue
+			}
+			best := []int{}
+			for _, label := range order {
+				switch {
+				case len(best) == 0 || weights[label] > weights[best[0]]:
+					best = []int{label}
+				case weights[label] == weights[best[0]]:
+					best = append(best, label)
+				}
+			}
+			if slices.Contains(best, labels[node]) {
+				continue
+			}
+			labels[node] = best[config.random.IntN(len(best))]
+			changed = true
+		}
+	}
+	return newCommunities(nodes, labels, network)
+}
+
+// Louvain greedily moves nodes between communities while modularity improves, then merges every
+// community into a single node and repeats on the smaller network until no move helps.
+func (g *Graph[T, W]) Louvain(options ...CommunityOption) *Communities[T] {
+	config := newCommunityConfig(options)
+	nodes := g.Nodes()
+	original := newCommunityNetwork(g, nodes)
+	membership := make([]int, len(nodes))
+	for i := range membership {
+		membership[i] = i
+	}
+	network := original
+	for {
+		community, moved := network.localMoves(config.random, config.maxSweeps)
+		if !moved {
+			break
+		}
+		community, count := renumber(community)
+		for i := range membership {
+			membership[i] = community[membership[i]]
+		}
+		network = network.aggregate(community, count)
+	}
+	return newCommunities(nodes, membership, original)
+}
+
+const modularityEpsilon = 1e-12
+
+func (n *communityNetwork) localMoves(random *rand.Rand, maxSweeps int) ([]int, bool) {
+	community := make([]int, len(n.links))
+	total := slices.Clone(n.degree)
+	for i := range community {
+		community[i] = i
+	}
+	if n.total == 0 {
+		return community, false
+	}
+	moved := false
+	for sweep, improved := 0, true; improved && sweep < maxSweeps; sweep++ {
+		improved = false
+		for _, node := range random.Perm(len(n.links)) {
+			current := community[node]
+			order, weights := n.neighbourCommunities(node, community)
+			total[current] -= n.degree[node]
+			best, bestGain := current, weights[current]-total[current]*n.degree[node]/n.total
+			for _, c := range order {
+				if gain := weights[c] - total[c]*n.degree[node]/n.total; gain > bestGain+modularityEpsilon {
+					best, bestGain = c, gain
+				}
+			}
+			total[best] += n.degree[node]
+			if best != current {
+				community[node] = best
+				improved, moved = true, true
+			}
+		}
+	}
+	return community, moved
+}
+
+func (n *communityNetwork) aggregate(community []int, count int) *communityNetwork {
+	weights := make([]map[int]float64, count)
+	for i := range weights {
+		weights[i] = make(map[int]float64)
+	}
+	for i, links := range n.links {
+		for _, link := range links {
+			weights[community[i]][community[link.to]] += link.weight
+		}
+	}
+	return buildCommunityNetwork(weights)
+}
+
+// renumber relabels communities from zero in order of first appearance.
+func renumber(community []int) ([]int, int) {
+	ids := make(map[int]int)
+	renumbered := make([]int, len(community))
+	for i, c := range community {
+		id, ok := ids[c]
+		if !ok {
+			id = len(ids)
+			ids[c] = id
+		}
+		renumbered[i] = id
+	}
+	return renumbered, len(ids)
+}
+
+func newCommunities[T comparable](nodes []T, community []int, network *communityNetwork) *Communities[T] {
+	community, count := renumber(community)
+	membership := make(map[T]int, len(nodes))
+	for i, node := range nodes {
+		membership[node] = community[i]
+	}
+	return &Communities[T]{
+		Membership: membership,
+		Count:      count,
+		Modularity: network.modularity(community),
+	}
+}
@@ -0,0 +1,164 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func createTrianglesGraph() *Graph[string, int] {
+	g := New[string](WithUndirected())
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("B", "C", 1)
+	g.AddEdge("C", "A", 1)
+	g.AddEdge("D", "E", 1)
+	g.AddEdge("E", "F", 1)
+	g.AddEdge("F", "D", 1)
+	g.AddEdge("C", "D", 1)
+	return g
+}
+
+// createCliqueRing joins count cliques of size nodes into a ring with single edges.
+func createCliqueRing(count, size int) *Graph[string, int] {
+	g := New[string]()
+	for c := range count {
+		for i := range size {
+			for j := i + 1; j < size; j++ {
+				g.AddEdge(fmt.Sprintf("%d-%d", c, i), fmt.Sprintf("%d-%d", c, j), 1)
+			}
+		}
+		g.AddEdge(fmt.Sprintf("%d-0", c), fmt.Sprintf("%d-1", (c+1)%count), 1)
+	}
+	return g
+}
+
+func assertModularity(t *testing.T, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("modularity got: %v, want: %v", got, want)
+	}
+}
+
+func TestGraph_Modularity(t *testing.T) {
+	g := createTrianglesGraph()
+	assertModularity(t, g.Modularity(map[string]int{"A": 0, "B": 0, "C": 0, "D": 1, "E": 1, "F": 1}), 5.0/14)
+	assertModularity(t, g.Modularity(map[string]int{"A": 0, "B": 0, "C": 0, "D": 0, "E": 0, "F": 0}), 0)
+	assertModularity(t, g.Modularity(map[string]int{}), -34.0/196)
+	assertModularity(t, New[string]().Modularity(nil), 0)
+}
+
+func TestGraph_Louvain(t *testing.T) {
+	t.Run("empty graph has no communities", func(t *testing.T) {
+		communities := New[string]().Louvain(WithSeed(1))
+		assertx.Equal(t, communities.Membership, map[string]int{})
+		assertx.Equal(t, communities.Count, 0)
+	})
+	t.Run("edgeless nodes keep their own community", func(t *testing.T) {
+		g := New[string]()
+		g.AddNode("A")
+		g.AddEdge("B", "C", 0)
+		communities := g.Louvain()
+		assertx.Equal(t, communities.Membership, map[string]int{"A": 0, "B": 1, "C": 2})
+		assertx.Equal(t, communities.Modularity, 0.0)
+	})
+	t.Run("triangles joined by a bridge", func(t *testing.T) {
+		for seed := range uint64(10) {
+			communities := createTrianglesGraph().Louvain(WithSeed(seed))
+			assertx.Equal(t, communities.Membership, map[string]int{"A": 0, "B": 0, "C": 0, "D": 1, "E": 1, "F": 1})
+			assertx.Equal(t, communities.Count, 2)
+			assertModularity(t, communities.Modularity, 5.0/14)
+		}
+	})
+	t.Run("ring of cliques finds every clique", func(t *testing.T) {
+		g := createCliqueRing(6, 5)
+		communities := g.Louvain(WithSeed(7))
+		assertx.Equal(t, communities.Count, 6)
+		for node, community := range communities.Membership {
+			assertx.Equal(t, community, communities.Membership[node[:1]+"-0"])
+		}
+		assertModularity(t, communities.Modularity, g.Modularity(communities.Membership))
+	})
+	t.Run("same seed reproduces the result exactly", func(t *testing.T) {
+		g := createCliqueRing(6, 5)
+		g.AddEdge("0-2", "3-3", 1)
+		g.AddEdge("1-4", "4-1", 1)
+		first := g.Louvain(WithSeed(9))
+		for range 20 {
+			communities := g.Louvain(WithSeed(9))
+			assertx.Equal(t, communities, first)
+			assertx.Equal(t, g.Modularity(communities.Membership), first.Modularity)
+		}
+	})
+	t.Run("heavy edges pull nodes together", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 10)
+		g.AddEdge("B", "C", 1)
+		g.AddEdge("C", "D", 10)
+		g.AddEdge("D", "A", 1)
+		communities := g.Louvain(WithSeed(3))
+		assertx.Equal(t, communities.Membership, map[string]int{"A": 0, "B": 0, "C": 1, "D": 1})
+	})
+}
+
+func TestGraph_LabelPropagation(t *testing.T) {
+	t.Run("empty graph has no communities", func(t *testing.T) {
+		communities := New[string]().LabelPropagation()
+		assertx.Equal(t, communities.Count, 0)
+	})
+	t.Run("cliques are never split", func(t *testing.T) {
+		g := createCliqueRing(4, 6)
+		communities := g.LabelPropagation(WithSeed(11))
+		assertx.True(t, communities.Count <= 4)
+		for node, community := range communities.Membership {
+			assertx.Equal(t, community, communities.Membership[node[:1]+"-0"])
+		}
+		assertModularity(t, communities.Modularity, g.Modularity(communities.Membership))
+	})
+	t.Run("same seed reproduces the result", func(t *testing.T) {
+		g := createCliqueRing(8, 3)
+		first := g.LabelPropagation(WithSeed(42))
+		for range 5 {
+			assertx.Equal(t, g.LabelPropagation(WithSeed(42)), first)
+		}
+	})
+	t.Run("sweeps are capped", func(t *testing.T) {
+		g := createTrianglesGraph()
+		communities := g.LabelPropagation(WithSeed(1), WithMaxSweeps(0))
+		assertx.Equal(t, communities.Count, 6)
+		communities = g.LabelPropagation(WithSeed(1), WithMaxSweeps(1))
+		assertx.True(t, communities.Count < 6)
+		assertx.Equal(t, g.Louvain(WithSeed(1), WithMaxSweeps(0)).Count, 6)
+	})
+	t.Run("negative weights stop at the sweep cap", func(t *testing.T) {
+		g := createCliqueRing(4, 4)
+		for _, edge := range g.Edges() {
+			g.SetWeight(edge.From, edge.To, -1)
+		}
+		communities := g.LabelPropagation(WithSeed(2), WithMaxSweeps(10))
+		assertx.Equal(t, len(communities.Membership), 16)
+	})
+	t.Run("isolated node keeps its own label", func(t *testing.T) {
+		g := createTrianglesGraph()
+		g.AddNode("G")
+		communities := g.LabelPropagation(WithSeed(5))
+		assertx.Equal(t, communities.Membership["G"], communities.Count-1)
+	})
+}
+
+func BenchmarkLouvain_50(b *testing.B) {
+	g, _, _ := createGridGraph(50)
+	b.ResetTimer()
+	for b.Loop() {
+		g.Louvain(WithSeed(1))
+	}
+}
+
+func BenchmarkLabelPropagation_50(b *testing.B) {
+	g, _, _ := createGridGraph(50)
+	b.ResetTimer()
+	for b.Loop() {
+		g.LabelPropagation(WithSeed(1))
+	}
+}
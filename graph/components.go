@@ -0,0 +1,223 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// StronglyConnectedComponents uses Tarjan's algorithm and returns the components in topological order,
+// so no component has an edge into a component listed before it.
+func (g *Graph[T, W]) StronglyConnectedComponents() [][]T {
+	index := make(map[T]int)
+	lowLink := make(map[T]int)
+	onStack := make(map[T]bool)
+	stack := []T{}
+	components := [][]T{}
+	var connect func(node T)
+	connect = func(node T) {
+		index[node] = len(index)
+		lowLink[node] = index[node]
+		stack = append(stack, node)
+		onStack[node] = true
+		for _, edge := range g.adjacency[node] {
+			link := edge.Link
+			if _, ok := index[link]; !ok {
+				connect(link)
+				lowLink[node] = min(lowLink[node], lowLink[link])
+			} else if onStack[link] {
+				lowLink[node] = min(lowLink[node], index[link])
+			}
+		}
+		if lowLink[node] != index[node] {
+			return
+		}
+		component := []T{}
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last)
+			if last == node {
+				break
+			}
+		}
+		slices.Reverse(component)
+		components = append(components, component)
+	}
+	for _, node := range g.Nodes() {
+		if _, ok := index[node]; !ok {
+			connect(node)
+		}
+	}
+	slices.Reverse(components)
+	return components
+}
+
+// Condensation collapses every strongly connected component into a single node identified by its
+// index in the returned components. Edges between components keep the smallest crossing weight,
+// which makes the result a DAG suitable for TopologicalSort.
+func (g *Graph[T, W]) Condensation() (*Graph[int, W], [][]T) {
+	components := g.StronglyConnectedComponents()
+	membership := make(map[T]int, g.Len())
+	for i, component := range components {
+		for _, node := range component {
+			membership[node] = i
+		}
+	}
+	condensed := NewWeighted[int, W]()
+	for i, component := range components {
+		condensed.AddNode(i)
+		weights := make(map[int]W)
+		targets := []int{}
+		for _, node := range component {
+			for _, edge := range g.adjacency[node] {
+				target := membership[edge.Link]
+				if target == i {
+					continue
+				}
+				weight, ok := weights[target]
+				if !ok {
+					targets = append(targets, target)
+				}
+				if !ok || edge.Weight < weight {
+					weights[target] = edge.Weight
+				}
+			}
+		}
+		for _, target := range targets {
+			condensed.AddEdge(i, target, weights[target])
+		}
+	}
+	return condensed, components
+}
+
+type biconnectivity[T comparable, W Weight] struct {
+	discovery          map[T]int
+	low                map[T]int
+	articulationPoints map[T]bool
+	bridges            []Arc[T, W]
+	components         [][]T
+	edges              []Arc[T, W]
+}
+
+// biconnectivity runs a single depth first search collecting cut vertices, cut edges and biconnected
+// components. Self loops are ignored and only the edge back to the parent is skipped, so parallel
+// edges keep two nodes biconnected.
+func (g *Graph[T, W]) biconnectivity() (*biconnectivity[T, W], error) {
+	if !g.undirected {
+		return nil, fmt.Errorf("%w: use AsUndirected to ignore edge direction", ErrDirected)
+	}
+	b := &biconnectivity[T, W]{
+		discovery:          make(map[T]int, g.Len()),
+		low:                make(map[T]int, g.Len()),
+		articulationPoints: make(map[T]bool),
+		bridges:            []Arc[T, W]{},
+		components:         [][]T{},
+	}
+	nodes := g.Nodes()
+	position := positions(nodes)
+	var connect func(node, parent T, hasParent bool)
+	connect = func(node, parent T, hasParent bool) {
+		b.discovery[node] = len(b.discovery)
+		b.low[node] = b.discovery[node]
+		children := 0
+		skipped := false
+		for _, edge := range g.adjacency[node] {
+			link := edge.Link
+			if link == node {
+				continue
+			}
+			if hasParent && !skipped && link == parent {
+				skipped = true
+				continue
+			}
+			arc := Arc[T, W]{From: node, To: link, Weight: edge.Weight}
+			if position[link] < position[node] {
+				arc.From, arc.To = link, node
+			}
+			discovered, ok := b.discovery[link]
+			if ok {
+				if discovered < b.discovery[node] {
+					b.low[node] = min(b.low[node], discovered)
+					b.edges = append(b.edges, arc)
+				}
+				continue
+			}
+			children++
+			mark := len(b.edges)
+			b.edges = append(b.edges, arc)
+			connect(link, node, true)
+			b.low[node] = min(b.low[node], b.low[link])
+			if b.low[link] > b.discovery[node] {
+				b.bridges = append(b.bridges, arc)
+			}
+			if b.low[link] >= b.discovery[node] {
+				if hasParent || children > 1 {
+					b.articulationPoints[node] = true
+				}
+				b.popComponent(mark, position)
+			}
+		}
+	}
+	for _, node := range nodes {
+		if _, ok := b.discovery[node]; !ok {
+			connect(node, node, false)
+		}
+	}
+	return b, nil
+}
+
+// popComponent takes every edge pushed since mark off the stack as one component.
+func (b *biconnectivity[T, W]) popComponent(mark int, position map[T]int) {
+	members := make(map[T]bool)
+	for _, edge := range b.edges[mark:] {
+		members[edge.From] = true
+		members[edge.To] = true
+	}
+	b.edges = b.edges[:mark]
+	component := make([]T, 0, len(members))
+	for node := range members {
+		component = append(component, node)
+	}
+	slices.SortFunc(component, func(a, b T) int {
+		return position[a] - position[b]
+	})
+	b.components = append(b.components, component)
+}
+
+// ArticulationPoints returns the nodes whose removal disconnects their component.
+// ErrDirected is returned for directed graphs, use AsUndirected to ignore edge direction.
+func (g *Graph[T, W]) ArticulationPoints() ([]T, error) {
+	b, err := g.biconnectivity()
+	if err != nil {
+		return nil, err
+	}
+	points := []T{}
+	for _, node := range g.Nodes() {
+		if b.articulationPoints[node] {
+			points = append(points, node)
+		}
+	}
+	return points, nil
+}
+
+// Bridges returns the edges whose removal disconnects their component.
+// ErrDirected is returned for directed graphs, use AsUndirected to ignore edge direction.
+func (g *Graph[T, W]) Bridges() ([]Arc[T, W], error) {
+	b, err := g.biconnectivity()
+	if err != nil {
+		return nil, err
+	}
+	return b.bridges, nil
+}
+
+// BiconnectedComponents returns the nodes of every maximal biconnected subgraph, a bridge forms a
+// component of its own and nodes without edges belong to none.
+// ErrDirected is returned for directed graphs, use AsUndirected to ignore edge direction.
+func (g *Graph[T, W]) BiconnectedComponents() ([][]T, error) {
+	b, err := g.biconnectivity()
+	if err != nil {
+		return nil, err
+	}
+	return b.components, nil
+}
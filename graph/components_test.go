@@ -0,0 +1,175 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func TestGraph_StronglyConnectedComponents(t *testing.T) {
+	t.Run("empty graph has no components", func(t *testing.T) {
+		g := New[string]()
+		assertx.Equal(t, g.StronglyConnectedComponents(), [][]string{})
+	})
+	t.Run("dag has a component per node in topological order", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("B", "C", 0)
+		g.AddEdge("A", "C", 0)
+		assertx.Equal(t, g.StronglyConnectedComponents(), [][]string{{"A"}, {"B"}, {"C"}})
+	})
+	t.Run("cycles are grouped into components", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("B", "C", 0)
+		g.AddEdge("C", "A", 0)
+		g.AddEdge("C", "D", 0)
+		g.AddEdge("D", "E", 0)
+		g.AddEdge("E", "D", 0)
+		g.AddEdge("F", "F", 0)
+		g.AddEdge("F", "A", 0)
+		assertx.Equal(t, g.StronglyConnectedComponents(), [][]string{{"F"}, {"A", "B", "C"}, {"D", "E"}})
+	})
+}
+
+func TestGraph_Condensation(t *testing.T) {
+	t.Run("empty graph condenses to empty graph", func(t *testing.T) {
+		g := New[string]()
+		condensed, components := g.Condensation()
+		assertx.Equal(t, condensed.Len(), 0)
+		assertx.Equal(t, len(components), 0)
+	})
+	t.Run("components collapse into a dag", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 5)
+		g.AddEdge("B", "A", 1)
+		g.AddEdge("A", "C", 7)
+		g.AddEdge("B", "C", 3)
+		g.AddEdge("C", "D", 2)
+		g.AddEdge("D", "C", 2)
+		g.AddEdge("E", "A", 4)
+		assertx.True(t, g.HasCycle())
+		condensed, components := g.Condensation()
+		assertx.Equal(t, components, [][]string{{"E"}, {"A", "B"}, {"C", "D"}})
+		assertx.False(t, condensed.HasCycle())
+		assertx.Equal(t, condensed.String(), "0 -> 1 (4)"+"\n"+"1 -> 2 (3)"+"\n"+"2")
+		sorted, err := condensed.TopologicalSort()
+		assertx.Nil(t, err)
+		assertx.Equal(t, sorted, []int{0, 1, 2})
+	})
+}
+
+func createServiceTopology() *Graph[string, int] {
+	g := New[string](WithUndirected())
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("B", "C", 2)
+	g.AddEdge("C", "A", 3)
+	g.AddEdge("C", "D", 4)
+	g.AddEdge("D", "E", 5)
+	g.AddEdge("E", "F", 6)
+	g.AddEdge("F", "D", 7)
+	g.AddEdge("F", "G", 8)
+	g.AddEdge("H", "H", 9)
+	/*
+		A - B     E
+		 \ /     / \
+		  C --- D - F --- G    H
+	*/
+	return g
+}
+
+func TestGraph_ArticulationPoints(t *testing.T) {
+	t.Run("directed graph yields error", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		points, err := g.ArticulationPoints()
+		assertx.ErrorIs(t, err, ErrDirected)
+		assertx.Nil(t, points)
+	})
+	t.Run("cut vertices are found", func(t *testing.T) {
+		points, err := createServiceTopology().ArticulationPoints()
+		assertx.Nil(t, err)
+		assertx.Equal(t, points, []string{"C", "D", "F"})
+	})
+	t.Run("root with several children is a cut vertex", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", 1)
+		points, err := g.ArticulationPoints()
+		assertx.Nil(t, err)
+		assertx.Equal(t, points, []string{"A"})
+	})
+	t.Run("directed edges treated as undirected", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "A", 1)
+		g.AddEdge("C", "B", 1)
+		points, err := g.AsUndirected().ArticulationPoints()
+		assertx.Nil(t, err)
+		assertx.Equal(t, points, []string{"B"})
+	})
+}
+
+func TestGraph_Bridges(t *testing.T) {
+	t.Run("directed graph yields error", func(t *testing.T) {
+		g := New[string]()
+		bridges, err := g.Bridges()
+		assertx.ErrorIs(t, err, ErrDirected)
+		assertx.Nil(t, bridges)
+	})
+	t.Run("cut edges are found", func(t *testing.T) {
+		bridges, err := createServiceTopology().Bridges()
+		assertx.Nil(t, err)
+		assertx.Equal(t, bridges, []Arc[string, int]{
+			{From: "F", To: "G", Weight: 8},
+			{From: "C", To: "D", Weight: 4},
+		})
+	})
+	t.Run("parallel edges are not bridges", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 2)
+		bridges, err := g.Bridges()
+		assertx.Nil(t, err)
+		assertx.Equal(t, bridges, []Arc[string, int]{{From: "B", To: "C", Weight: 2}})
+		components, err := g.BiconnectedComponents()
+		assertx.Nil(t, err)
+		assertx.Equal(t, components, [][]string{{"B", "C"}, {"A", "B"}})
+	})
+	t.Run("antiparallel directed edges collapse into a bridge", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "A", 2)
+		bridges, err := g.AsUndirected().Bridges()
+		assertx.Nil(t, err)
+		assertx.Equal(t, bridges, []Arc[string, int]{{From: "A", To: "B", Weight: 1}})
+	})
+}
+
+func TestGraph_BiconnectedComponents(t *testing.T) {
+	t.Run("directed graph yields error", func(t *testing.T) {
+		g := New[string]()
+		components, err := g.BiconnectedComponents()
+		assertx.ErrorIs(t, err, ErrDirected)
+		assertx.Nil(t, components)
+	})
+	t.Run("components split at cut vertices", func(t *testing.T) {
+		components, err := createServiceTopology().BiconnectedComponents()
+		assertx.Nil(t, err)
+		assertx.Equal(t, components, [][]string{{"F", "G"}, {"D", "E", "F"}, {"C", "D"}, {"A", "B", "C"}})
+	})
+}
+
+func TestGraph_AsUndirected(t *testing.T) {
+	g := New[string](WithEdgePolicy(RejectParallelEdges))
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("B", "A", 2)
+	g.AddEdge("B", "C", 3)
+	g.AddNode("D")
+	undirected := g.AsUndirected()
+	assertx.True(t, g.Directed())
+	assertx.False(t, undirected.Directed())
+	assertx.Equal(t, undirected.String(), "A -- B (1)"+"\n"+"B -- C (3)"+"\n"+"C"+"\n"+"D")
+	assertx.ErrorIs(t, undirected.AddEdge("C", "B", 4), ErrEdgeExists)
+}
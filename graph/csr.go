@@ -0,0 +1,169 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/salsgithub/godst/heap"
+)
+
+// CSR is a read only snapshot of a graph in compressed sparse row form. Nodes get the IDs 0 to Len()-1
+// in the order of Graph.Nodes and the edges leaving node i are targets[offsets[i]:offsets[i+1]], so the
+// algorithms on it index slices instead of hashing nodes.
+type CSR[T comparable, W Weight] struct {
+	nodes      []T
+	ids        map[T]int
+	offsets    []int
+	targets    []int
+	weights    []W
+	undirected bool
+}
+
+// Freeze copies the graph into a CSR, later changes to the graph do not affect it.
+func (g *Graph[T, W]) Freeze() *CSR[T, W] {
+	nodes := g.Nodes()
+	ids := positions(nodes)
+	size := 0
+	for _, edges := range g.adjacency {
+		size += len(edges)
+	}
+	c := &CSR[T, W]{
+		nodes:      nodes,
+		ids:        ids,
+		offsets:    make([]int, 1, len(nodes)+1),
+		targets:    make([]int, 0, size),
+		weights:    make([]W, 0, size),
+		undirected: g.undirected,
+	}
+	for _, node := range nodes {
+		for _, edge := range g.adjacency[node] {
+			c.targets = append(c.targets, ids[edge.Link])
+			c.weights = append(c.weights, edge.Weight)
+		}
+		c.offsets = append(c.offsets, len(c.targets))
+	}
+	return c
+}
+
+func (c *CSR[T, W]) Len() int {
+	return len(c.nodes)
+}
+
+func (c *CSR[T, W]) Directed() bool {
+	return !c.undirected
+}
+
+func (c *CSR[T, W]) ID(node T) (int, bool) {
+	id, ok := c.ids[node]
+	return id, ok
+}
+
+// Node returns the node with the given ID, which must be between 0 and Len()-1.
+func (c *CSR[T, W]) Node(id int) T {
+	return c.nodes[id]
+}
+
+// Neighbours returns the targets and weights of the edges leaving id as views into the CSR that must
+// not be modified.
+func (c *CSR[T, W]) Neighbours(id int) ([]int, []W) {
+	start, end := c.offsets[id], c.offsets[id+1]
+	return c.targets[start:end:end], c.weights[start:end:end]
+}
+
+func (c *CSR[T, W]) valid(id int) bool {
+	return id >= 0 && id < len(c.nodes)
+}
+
+func (c *CSR[T, W]) BFS(start int, onVisit func(id int)) error {
+	if !c.valid(start) {
+		return fmt.Errorf("start %d not found in graph", start)
+	}
+	visited := make([]bool, len(c.nodes))
+	visited[start] = true
+	queue := make([]int, 1, len(c.nodes))
+	queue[0] = start
+	for head := 0; head < len(queue); head++ {
+		node := queue[head]
+		onVisit(node)
+		for _, link := range c.targets[c.offsets[node]:c.offsets[node+1]] {
+			if !visited[link] {
+				visited[link] = true
+				queue = append(queue, link)
+			}
+		}
+	}
+	return nil
+}
+
+// DFS visits nodes in the same order as Graph.DFS.
+func (c *CSR[T, W]) DFS(start int, onVisit func(id int)) error {
+	if !c.valid(start) {
+		return fmt.Errorf("start %d not found in graph", start)
+	}
+	visited := make([]bool, len(c.nodes))
+	stack := []int{start}
+	for len(stack) > 0 {
+		last := len(stack) - 1
+		node := stack[last]
+		stack = stack[:last]
+		if visited[node] {
+			continue
+		}
+		visited[node] = true
+		onVisit(node)
+		for i := c.offsets[node+1] - 1; i >= c.offsets[node]; i-- {
+			if link := c.targets[i]; !visited[link] {
+				stack = append(stack, link)
+			}
+		}
+	}
+	return nil
+}
+
+// Dijkstra returns the IDs along the cheapest path and its cost, weights must not be negative.
+func (c *CSR[T, W]) Dijkstra(start, end int) ([]int, W, error) {
+	if !c.valid(start) {
+		return nil, 0, fmt.Errorf("start node %d not found", start)
+	}
+	if !c.valid(end) {
+		return nil, 0, fmt.Errorf("end node %d not found", end)
+	}
+	distances := make([]W, len(c.nodes))
+	parents := make([]int, len(c.nodes))
+	reached := make([]bool, len(c.nodes))
+	reached[start] = true
+	parents[start] = -1
+	queue := heap.New(func(a, b priorityNode[int, W]) bool {
+		return a.priority < b.priority
+	})
+	queue.Push(priorityNode[int, W]{node: start, priority: 0})
+	for !queue.IsEmpty() {
+		pop, _ := queue.Pop()
+		node := pop.node
+		if pop.priority > distances[node] {
+			continue
+		}
+		if node == end {
+			break
+		}
+		for i := c.offsets[node]; i < c.offsets[node+1]; i++ {
+			link := c.targets[i]
+			travelDistance := distances[node] + c.weights[i]
+			if !reached[link] || travelDistance < distances[link] {
+				reached[link] = true
+				distances[link] = travelDistance
+				parents[link] = node
+				queue.Push(priorityNode[int, W]{node: link, priority: travelDistance})
+			}
+		}
+	}
+	if !reached[end] {
+		return nil, 0, fmt.Errorf("path from %d to %d not found", start, end)
+	}
+	path := []int{}
+	for node := end; node != -1; node = parents[node] {
+		path = append(path, node)
+	}
+	slices.Reverse(path)
+	return path, distances[end], nil
+}
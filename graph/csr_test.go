@@ -0,0 +1,150 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func TestGraph_Freeze(t *testing.T) {
+	t.Run("layout follows node order", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "C", 2)
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("C", "A", 3)
+		g.AddNode("D")
+		c := g.Freeze()
+		assertx.Equal(t, c.Len(), 4)
+		assertx.True(t, c.Directed())
+		assertx.Equal(t, c.offsets, []int{0, 2, 2, 3, 3})
+		assertx.Equal(t, c.targets, []int{2, 1, 0})
+		assertx.Equal(t, c.weights, []int{2, 1, 3})
+		id, ok := c.ID("C")
+		assertx.True(t, ok)
+		assertx.Equal(t, id, 2)
+		assertx.Equal(t, c.Node(id), "C")
+		_, ok = c.ID("E")
+		assertx.False(t, ok)
+		targets, weights := c.Neighbours(0)
+		assertx.Equal(t, targets, []int{2, 1})
+		assertx.Equal(t, weights, []int{2, 1})
+		targets, weights = c.Neighbours(3)
+		assertx.Equal(t, targets, []int{})
+		assertx.Equal(t, weights, []int{})
+	})
+	t.Run("snapshot ignores later changes", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		c := g.Freeze()
+		g.AddEdge("B", "C", 1)
+		g.DeleteNode("A")
+		assertx.False(t, c.Directed())
+		assertx.Equal(t, c.Len(), 2)
+		assertx.Equal(t, c.targets, []int{1, 0})
+	})
+}
+
+func TestCSR_Traversal(t *testing.T) {
+	g := createTraversalGraph()
+	c := g.Freeze()
+	for _, node := range g.Nodes() {
+		id, _ := c.ID(node)
+		want := []string{}
+		g.BFS(node, func(node string) {
+			want = append(want, node)
+		})
+		got := []string{}
+		assertx.Nil(t, c.BFS(id, func(id int) {
+			got = append(got, c.Node(id))
+		}))
+		assertx.Equal(t, got, want)
+		want = []string{}
+		g.DFS(node, func(node string) {
+			want = append(want, node)
+		})
+		got = []string{}
+		assertx.Nil(t, c.DFS(id, func(id int) {
+			got = append(got, c.Node(id))
+		}))
+		assertx.Equal(t, got, want)
+	}
+	assertx.NotNil(t, c.BFS(-1, func(id int) {}))
+	assertx.NotNil(t, c.DFS(c.Len(), func(id int) {}))
+}
+
+func TestCSR_Dijkstra(t *testing.T) {
+	t.Run("missing nodes yield error", func(t *testing.T) {
+		c := New[string]().Freeze()
+		_, _, err := c.Dijkstra(0, 0)
+		assertx.NotNil(t, err)
+		g := New[string]()
+		g.AddNode("A")
+		_, _, err = g.Freeze().Dijkstra(0, 1)
+		assertx.NotNil(t, err)
+	})
+	t.Run("unreachable end yields error", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		path, distance, err := g.Freeze().Dijkstra(1, 0)
+		assertx.NotNil(t, err)
+		assertx.Nil(t, path)
+		assertx.Equal(t, distance, 0)
+	})
+	t.Run("start is its own path", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		path, distance, err := g.Freeze().Dijkstra(0, 0)
+		assertx.Nil(t, err)
+		assertx.Equal(t, path, []int{0})
+		assertx.Equal(t, distance, 0)
+	})
+	t.Run("matches the graph on a grid", func(t *testing.T) {
+		g, start, end := createGridGraph(30)
+		c := g.Freeze()
+		startID, _ := c.ID(start)
+		endID, _ := c.ID(end)
+		want, wantDistance, err := g.Dijkstra(start, end)
+		assertx.Nil(t, err)
+		ids, distance, err := c.Dijkstra(startID, endID)
+		assertx.Nil(t, err)
+		assertx.Equal(t, distance, wantDistance)
+		got := []coord{}
+		for _, id := range ids {
+			got = append(got, c.Node(id))
+		}
+		assertx.Equal(t, g.pathCost(got), g.pathCost(want))
+		assertx.Equal(t, got[0], start)
+		assertx.Equal(t, got[len(got)-1], end)
+	})
+}
+
+func BenchmarkCSR_BFS_1_000(b *testing.B) {
+	g, start, _ := createGridGraph(1_000)
+	c := g.Freeze()
+	id, _ := c.ID(start)
+	b.ResetTimer()
+	for b.Loop() {
+		c.BFS(id, func(id int) {})
+	}
+}
+
+func BenchmarkCSR_DFS_1_000(b *testing.B) {
+	g, start, _ := createGridGraph(1_000)
+	c := g.Freeze()
+	id, _ := c.ID(start)
+	b.ResetTimer()
+	for b.Loop() {
+		c.DFS(id, func(id int) {})
+	}
+}
+
+func BenchmarkCSR_Dijkstra_1_000(b *testing.B) {
+	g, start, end := createGridGraph(1_000)
+	c := g.Freeze()
+	startID, _ := c.ID(start)
+	endID, _ := c.ID(end)
+	b.ResetTimer()
+	for b.Loop() {
+		c.Dijkstra(startID, endID)
+	}
+}
@@ -0,0 +1,181 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// WeightChange records an edge whose weight changed from Old to New.
+type WeightChange[T comparable, W Weight] struct {
+	From T
+	To   T
+	Old  W
+	New  W
+}
+
+// ChangeSet lists the changes turning one graph into another, in the order of Nodes and Edges of the
+// graph each change comes from. Edges touching a removed node are listed in RemovedEdges.
+type ChangeSet[T comparable, W Weight] struct {
+	AddedNodes    []T
+	RemovedNodes  []T
+	AddedEdges    []Arc[T, W]
+	RemovedEdges  []Arc[T, W]
+	WeightChanges []WeightChange[T, W]
+}
+
+func (c *ChangeSet[T, W]) IsEmpty() bool {
+	return len(c.AddedNodes) == 0 && len(c.RemovedNodes) == 0 && len(c.AddedEdges) == 0 &&
+		len(c.RemovedEdges) == 0 && len(c.WeightChanges) == 0
+}
+
+type groupedEdges[T comparable, W Weight] struct {
+	pairs   []edgeKey[T]
+	weights map[edgeKey[T]][]W
+}
+
+func (g *Graph[T, W]) groupEdges() *groupedEdges[T, W] {
+	grouped := &groupedEdges[T, W]{weights: make(map[edgeKey[T]][]W)}
+	for _, edge := range g.Edges() {
+		key := edgeKey[T]{from: edge.From, to: edge.To}
+		if _, ok := grouped.weights[key]; !ok {
+			grouped.pairs = append(grouped.pairs, key)
+		}
+		grouped.weights[key] = append(grouped.weights[key], edge.Weight)
+	}
+	return grouped
+}
+
+// Diff compares two graphs edge by edge. Parallel edges are matched by weight first and the remaining
+// ones are reported as weight changes, edges between the same nodes in either direction match when
+// both graphs are undirected. Attributes are not compared.
+func Diff[T comparable, W Weight](before, after *Graph[T, W]) *ChangeSet[T, W] {
+	changes := &ChangeSet[T, W]{}
+	for _, node := range before.Nodes() {
+		if _, ok := after.adjacency[node]; !ok {
+			changes.RemovedNodes = append(changes.RemovedNodes, node)
+		}
+	}
+	for _, node := range after.Nodes() {
+		if _, ok := before.adjacency[node]; !ok {
+			changes.AddedNodes = append(changes.AddedNodes, node)
+		}
+	}
+	beforeEdges, afterEdges := before.groupEdges(), after.groupEdges()
+	matched := make(map[edgeKey[T]]bool)
+	for _, key := range beforeEdges.pairs {
+		afterKey := key
+		if _, ok := afterEdges.weights[key]; !ok && before.undirected && after.undirected {
+			afterKey = edgeKey[T]{from: key.to, to: key.from}
+		}
+		matched[afterKey] = true
+		changes.diffPair(key, beforeEdges.weights[key], afterEdges.weights[afterKey])
+	}
+	for _, key := range afterEdges.pairs {
+		if !matched[key] {
+			changes.diffPair(key, nil, afterEdges.weights[key])
+		}
+	}
+	return changes
+}
+
+func (c *ChangeSet[T, W]) diffPair(key edgeKey[T], beforeWeights, afterWeights []W) {
+	remaining := slices.Clone(afterWeights)
+	unmatched := []W{}
+	for _, weight := range beforeWeights {
+		if index := slices.Index(remaining, weight); index >= 0 {
+			remaining = slices.Delete(remaining, index, index+1)
+		} else {
+			unmatched = append(unmatched, weight)
+		}
+	}
+	for len(unmatched) > 0 && len(remaining) > 0 {
+		c.WeightChanges = append(c.WeightChanges, WeightChange[T, W]{From: key.from, To: key.to, Old: unmatched[0], New: remaining[0]})
+		unmatched, remaining = unmatched[1:], remaining[1:]
+	}
+	for _, weight := range unmatched {
+		c.RemovedEdges = append(c.RemovedEdges, Arc[T, W]{From: key.from, To: key.to, Weight: weight})
+	}
+	for _, weight := range remaining {
+		c.AddedEdges = append(c.AddedEdges, Arc[T, W]{From: key.from, To: key.to, Weight: weight})
+	}
+}
+
+// Apply replays a change set by removing edges, changing weights, removing nodes, adding nodes and
+// finally adding edges. Every change must match the graph, otherwise an error is returned and the
+// graph is left unchanged.
+func (g *Graph[T, W]) Apply(changes *ChangeSet[T, W]) error {
+	next := g.Clone()
+	for _, edge := range changes.RemovedEdges {
+		if !next.removeArc(edge.From, edge.To, edge.Weight) {
+			return fmt.Errorf("edge %v -> %v (%v) not found", edge.From, edge.To, edge.Weight)
+		}
+	}
+	for _, change := range changes.WeightChanges {
+		if !next.replaceWeight(change.From, change.To, change.Old, change.New) {
+			return fmt.Errorf("edge %v -> %v (%v) not found", change.From, change.To, change.Old)
+		}
+	}
+	for _, node := range changes.RemovedNodes {
+		if _, ok := next.adjacency[node]; !ok {
+			return fmt.Errorf("node %v not found", node)
+		}
+		next.DeleteNode(node)
+	}
+	for _, node := range changes.AddedNodes {
+		if _, ok := next.adjacency[node]; ok {
+			return fmt.Errorf("node %v already exists", node)
+		}
+		next.AddNode(node)
+	}
+	for _, edge := range changes.AddedEdges {
+		if err := next.AddEdge(edge.From, edge.To, edge.Weight); err != nil {
+			return err
+		}
+	}
+	*g = *next
+	return nil
+}
+
+// removeArc removes a single edge with the given weight, dropping the edge attributes once no edge
+// between the nodes is left.
+func (g *Graph[T, W]) removeArc(from, to T, weight W) bool {
+	if !g.editLink(from, to, weight, nil) {
+		return false
+	}
+	if g.undirected && from != to {
+		g.editLink(to, from, weight, nil)
+	}
+	if !g.HasEdge(from, to) {
+		for _, key := range g.edgeKeys(from, to) {
+			delete(g.edgeAttributes, key)
+		}
+	}
+	return true
+}
+
+func (g *Graph[T, W]) replaceWeight(from, to T, old, weight W) bool {
+	if !g.editLink(from, to, old, &weight) {
+		return false
+	}
+	if g.undirected && from != to {
+		g.editLink(to, from, old, &weight)
+	}
+	return true
+}
+
+// editLink changes the weight of the first matching edge, or removes it when weight is nil.
+func (g *Graph[T, W]) editLink(from, to T, old W, weight *W) bool {
+	edges := g.adjacency[from]
+	index := slices.IndexFunc(edges, func(edge Edge[T, W]) bool {
+		return edge.Link == to && edge.Weight == old
+	})
+	if index < 0 {
+		return false
+	}
+	if weight == nil {
+		g.adjacency[from] = slices.Delete(edges, index, index+1)
+	} else {
+		edges[index].Weight = *weight
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("identical graphs have no changes", func(t *testing.T) {
+		changes := Diff(createReleaseGraph(), createReleaseGraph())
+		assertx.True(t, changes.IsEmpty())
+		assertx.True(t, Diff(New[string](), New[string]()).IsEmpty())
+	})
+	t.Run("release changes", func(t *testing.T) {
+		before := createReleaseGraph()
+		after := New[string]()
+		after.AddEdge("api", "auth", 1)
+		after.AddEdge("api", "db", 5)
+		after.AddEdge("api", "cache", 4)
+		changes := Diff(before, after)
+		assertx.False(t, changes.IsEmpty())
+		assertx.Equal(t, changes, &ChangeSet[string, int]{
+			AddedNodes:    []string{"cache"},
+			RemovedNodes:  []string{"cli"},
+			AddedEdges:    []Arc[string, int]{{From: "api", To: "cache", Weight: 4}},
+			RemovedEdges:  []Arc[string, int]{{From: "auth", To: "db", Weight: 3}},
+			WeightChanges: []WeightChange[string, int]{{From: "api", To: "db", Old: 2, New: 5}},
+		})
+	})
+	t.Run("parallel edges match by weight first", func(t *testing.T) {
+		before := New[string]()
+		before.AddEdge("A", "B", 1)
+		before.AddEdge("A", "B", 2)
+		after := New[string]()
+		after.AddEdge("A", "B", 2)
+		after.AddEdge("A", "B", 3)
+		after.AddEdge("A", "B", 4)
+		changes := Diff(before, after)
+		assertx.Equal(t, changes.WeightChanges, []WeightChange[string, int]{{From: "A", To: "B", Old: 1, New: 3}})
+		assertx.Equal(t, changes.AddedEdges, []Arc[string, int]{{From: "A", To: "B", Weight: 4}})
+		assertx.Nil(t, changes.RemovedEdges)
+	})
+	t.Run("undirected edges match in either direction", func(t *testing.T) {
+		before := New[int64](WithUndirected())
+		before.AddEdge(1, 2, 3)
+		after := New[int64](WithUndirected())
+		after.AddNode(2)
+		after.AddEdge(1, 2, 4)
+		changes := Diff(before, after)
+		assertx.Equal(t, changes, &ChangeSet[int64, int]{
+			WeightChanges: []WeightChange[int64, int]{{From: 1, To: 2, Old: 3, New: 4}},
+		})
+	})
+	t.Run("directed edges do not match reversed edges", func(t *testing.T) {
+		before := New[string]()
+		before.AddEdge("A", "B", 1)
+		after := New[string]()
+		after.AddEdge("B", "A", 1)
+		changes := Diff(before, after)
+		assertx.Equal(t, changes.RemovedEdges, []Arc[string, int]{{From: "A", To: "B", Weight: 1}})
+		assertx.Equal(t, changes.AddedEdges, []Arc[string, int]{{From: "B", To: "A", Weight: 1}})
+	})
+}
+
+func TestGraph_Apply(t *testing.T) {
+	t.Run("replaying a diff reproduces the new graph", func(t *testing.T) {
+		before := createReleaseGraph()
+		before.AddEdge("api", "db", 8)
+		after := New[string]()
+		after.AddEdge("api", "auth", 1)
+		after.AddEdge("api", "db", 5)
+		after.AddEdge("cli", "api", 6)
+		after.AddEdge("api", "cache", 4)
+		assertx.Nil(t, before.Apply(Diff(before, after)))
+		assertx.Equal(t, before.String(), after.String())
+		assertx.True(t, Diff(before, after).IsEmpty())
+		assertx.Equal(t, before.EdgeAttributes("api", "db"), map[string]any{"protocol": "tcp"})
+	})
+	t.Run("undirected edges change in both directions", func(t *testing.T) {
+		before := New[string](WithUndirected())
+		before.AddEdge("A", "B", 1)
+		before.AddEdge("B", "C", 2)
+		before.SetEdgeAttribute("A", "B", "colour", "red")
+		after := New[string](WithUndirected())
+		after.AddEdge("B", "C", 7)
+		after.AddNode("A")
+		assertx.Nil(t, before.Apply(Diff(before, after)))
+		assertx.Equal(t, before.String(), after.String())
+		assertx.False(t, before.HasEdge("B", "A"))
+		assertx.Equal(t, before.EdgeAttributes("B", "A"), map[string]any{})
+		edge, _ := before.Edge("C", "B")
+		assertx.Equal(t, edge.Weight, 7)
+	})
+	t.Run("mismatched changes yield error and leave graph unchanged", func(t *testing.T) {
+		changes := []*ChangeSet[string, int]{
+			{RemovedEdges: []Arc[string, int]{{From: "api", To: "db", Weight: 9}}},
+			{WeightChanges: []WeightChange[string, int]{{From: "db", To: "api", Old: 2, New: 3}}},
+			{RemovedNodes: []string{"missing"}},
+			{AddedNodes: []string{"cli"}},
+			{
+				RemovedEdges: []Arc[string, int]{{From: "api", To: "auth", Weight: 1}},
+				AddedEdges:   []Arc[string, int]{{From: "api", To: "db", Weight: 3}},
+			},
+		}
+		for _, change := range changes {
+			g := createReleaseGraph()
+			rejecting := New[string](WithEdgePolicy(RejectParallelEdges))
+			assertx.Nil(t, rejecting.Apply(Diff(rejecting, g)))
+			expected := rejecting.String()
+			assertx.NotNil(t, rejecting.Apply(change))
+			assertx.Equal(t, rejecting.String(), expected)
+		}
+	})
+}
@@ -0,0 +1,223 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DominatorTree holds the dominators of every node reachable from an entry node. A node a dominates b
+// when every path from the entry to b passes through a, the immediate dominator of b is its closest
+// strict dominator and becomes its parent in the tree. Nodes are numbered in depth first order from the
+// entry and unreachable nodes are not part of the tree.
+type DominatorTree[T comparable] struct {
+	nodes     []T
+	index     map[T]int
+	idom      []int
+	children  [][]int
+	frontiers [][]int
+	pre       []int
+	post      []int
+}
+
+// Dominators builds the dominator tree of the nodes reachable from entry with the Lengauer-Tarjan
+// algorithm, together with every node's dominance frontier.
+func (g *Graph[T, W]) Dominators(entry T) (*DominatorTree[T], error) {
+	if g.undirected {
+		return nil, fmt.Errorf("%w: dominators require a directed graph", ErrUndirected)
+	}
+	if _, ok := g.adjacency[entry]; !ok {
+		return nil, fmt.Errorf("entry node %v not found", entry)
+	}
+	tree := &DominatorTree[T]{index: make(map[T]int)}
+	parent := []int{}
+	for visit := range g.DFSSeq(entry) {
+		tree.index[visit.Node] = len(tree.nodes)
+		tree.nodes = append(tree.nodes, visit.Node)
+		if visit.HasParent {
+			parent = append(parent, tree.index[visit.Parent])
+		} else {
+			parent = append(parent, -1)
+		}
+	}
+	size := len(tree.nodes)
+	predecessors := make([][]int, size)
+	for i, node := range tree.nodes {
+		for _, edge := range g.adjacency[node] {
+			link := tree.index[edge.Link]
+			predecessors[link] = append(predecessors[link], i)
+		}
+	}
+	tree.idom = lengauerTarjan(parent, predecessors)
+	tree.children = make([][]int, size)
+	for node := 1; node < size; node++ {
+		tree.children[tree.idom[node]] = append(tree.children[tree.idom[node]], node)
+	}
+	tree.number()
+	tree.frontiers = make([][]int, size)
+	for node, links := range predecessors {
+		for _, predecessor := range links {
+			for runner := predecessor; runner != tree.idom[node]; runner = tree.idom[runner] {
+				if !slices.Contains(tree.frontiers[runner], node) {
+					tree.frontiers[runner] = append(tree.frontiers[runner], node)
+				}
+			}
+		}
+	}
+	for _, frontier := range tree.frontiers {
+		slices.Sort(frontier)
+	}
+	return tree, nil
+}
+
+// PostDominators builds the dominator tree of the transposed graph from exit, so a node a post
+// dominates b when every path from b to exit passes through a. Graphs with several exits need a
+// single exit node joining them.
+func (g *Graph[T, W]) PostDominators(exit T) (*DominatorTree[T], error) {
+	if g.undirected {
+		return nil, fmt.Errorf("%w: post dominators require a directed graph", ErrUndirected)
+	}
+	return g.Transpose().Dominators(exit)
+}
+
+// lengauerTarjan computes immediate dominators of nodes numbered in depth first order with the entry
+// at zero, which has no immediate dominator.
+func lengauerTarjan(parent []int, predecessors [][]int) []int {
+	size := len(parent)
+	semi := make([]int, size)
+	idom := make([]int, size)
+	ancestor := make([]int, size)
+	label := make([]int, size)
+	bucket := make([][]int, size)
+	for node := range size {
+		semi[node], ancestor[node], label[node] = node, -1, node
+	}
+	eval := func(node int) int {
+		if ancestor[node] == -1 {
+			return node
+		}
+		path := []int{}
+		for current := node; ancestor[ancestor[current]] != -1; current = ancestor[current] {
+			path = append(path, current)
+		}
+		for i := len(path) - 1; i >= 0; i-- {
+			current := path[i]
+			if above := ancestor[current]; semi[label[above]] < semi[label[current]] {
+				label[current] = label[above]
+			}
+			ancestor[current] = ancestor[ancestor[current]]
+		}
+		return label[node]
+	}
+	for node := size - 1; node > 0; node-- {
+		for _, predecessor := range predecessors[node] {
+			if candidate := eval(predecessor); semi[candidate] < semi[node] {
+				semi[node] = semi[candidate]
+			}
+		}
+		bucket[semi[node]] = append(bucket[semi[node]], node)
+		ancestor[node] = parent[node]
+		for _, dominated := range bucket[parent[node]] {
+			if candidate := eval(dominated); semi[candidate] < semi[dominated] {
+				idom[dominated] = candidate
+			} else {
+				idom[dominated] = parent[node]
+			}
+		}
+		bucket[parent[node]] = nil
+	}
+	for node := 1; node < size; node++ {
+		if idom[node] != semi[node] {
+			idom[node] = idom[idom[node]]
+		}
+	}
+	if size > 0 {
+		idom[0] = -1
+	}
+	return idom
+}
+
+// number gives every node entry and exit times in the dominator tree so Dominates is a range check.
+func (d *DominatorTree[T]) number() {
+	d.pre = make([]int, len(d.nodes))
+	d.post = make([]int, len(d.nodes))
+	clock := 0
+	type frame struct {
+		node  int
+		child int
+	}
+	stack := []frame{{node: 0}}
+	d.pre[0] = clock
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.child < len(d.children[top.node]) {
+			child := d.children[top.node][top.child]
+			top.child++
+			clock++
+			d.pre[child] = clock
+			stack = append(stack, frame{node: child})
+			continue
+		}
+		clock++
+		d.post[top.node] = clock
+		stack = stack[:len(stack)-1]
+	}
+}
+
+func (d *DominatorTree[T]) Entry() T {
+	return d.nodes[0]
+}
+
+// Nodes returns the nodes reachable from the entry in depth first order.
+func (d *DominatorTree[T]) Nodes() []T {
+	return slices.Clone(d.nodes)
+}
+
+// ImmediateDominator returns the parent of node in the tree, the entry and unreachable nodes have none.
+func (d *DominatorTree[T]) ImmediateDominator(node T) (T, bool) {
+	index, ok := d.index[node]
+	if !ok || index == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.nodes[d.idom[index]], true
+}
+
+// Dominates reports whether a dominates b, every reachable node dominates itself.
+func (d *DominatorTree[T]) Dominates(a, b T) bool {
+	i, ok := d.index[a]
+	if !ok {
+		return false
+	}
+	j, ok := d.index[b]
+	if !ok {
+		return false
+	}
+	return d.pre[i] <= d.pre[j] && d.post[j] <= d.post[i]
+}
+
+// Children returns the nodes immediately dominated by node.
+func (d *DominatorTree[T]) Children(node T) []T {
+	index, ok := d.index[node]
+	if !ok {
+		return nil
+	}
+	return d.lookup(d.children[index])
+}
+
+// Frontier returns the dominance frontier of node: the nodes where its dominance ends, which have a
+// predecessor dominated by node without being strictly dominated by it.
+func (d *DominatorTree[T]) Frontier(node T) []T {
+	index, ok := d.index[node]
+	if !ok {
+		return nil
+	}
+	return d.lookup(d.frontiers[index])
+}
+
+func (d *DominatorTree[T]) lookup(indices []int) []T {
+	nodes := make([]T, 0, len(indices))
+	for _, index := range indices {
+		nodes = append(nodes, d.nodes[index])
+	}
+	return nodes
+}
@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func createLoopGraph() *Graph[string, int] {
+	g := New[string]()
+	g.AddEdge("entry", "A", 1)
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("A", "C", 1)
+	g.AddEdge("B", "D", 1)
+	g.AddEdge("C", "D", 1)
+	g.AddEdge("D", "A", 1)
+	g.AddEdge("D", "exit", 1)
+	return g
+}
+
+func immediateDominators[T comparable](tree *DominatorTree[T]) map[T]T {
+	idom := make(map[T]T)
+	for _, node := range tree.Nodes() {
+		if dominator, ok := tree.ImmediateDominator(node); ok {
+			idom[node] = dominator
+		}
+	}
+	return idom
+}
+
+func TestGraph_Dominators(t *testing.T) {
+	t.Run("undirected graph yields error", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddNode("A")
+		tree, err := g.Dominators("A")
+		assertx.ErrorIs(t, err, ErrUndirected)
+		assertx.Nil(t, tree)
+		tree, err = g.PostDominators("A")
+		assertx.ErrorIs(t, err, ErrUndirected)
+		assertx.Nil(t, tree)
+	})
+	t.Run("missing entry yields error", func(t *testing.T) {
+		tree, err := New[string]().Dominators("A")
+		assertx.NotNil(t, err)
+		assertx.Nil(t, tree)
+	})
+	t.Run("example from the Lengauer-Tarjan paper", func(t *testing.T) {
+		g := New[string]()
+		edges := map[string][]string{
+			"R": {"A", "B", "C"}, "A": {"D"}, "B": {"A", "D", "E"}, "C": {"F", "G"}, "D": {"L"}, "E": {"H"},
+			"F": {"I"}, "G": {"I", "J"}, "H": {"E", "K"}, "I": {"K"}, "J": {"I"}, "K": {"I", "R"}, "L": {"H"},
+		}
+		for _, from := range []string{"R", "A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L"} {
+			for _, to := range edges[from] {
+				g.AddEdge(from, to, 1)
+			}
+		}
+		g.AddEdge("X", "R", 1)
+		tree, err := g.Dominators("R")
+		assertx.Nil(t, err)
+		assertx.Equal(t, tree.Entry(), "R")
+		assertx.Equal(t, immediateDominators(tree), map[string]string{
+			"A": "R", "B": "R", "C": "R", "D": "R", "E": "R", "F": "C", "G": "C",
+			"H": "R", "I": "R", "J": "G", "K": "R", "L": "D",
+		})
+		_, ok := tree.ImmediateDominator("X")
+		assertx.False(t, ok)
+		assertx.True(t, tree.Dominates("C", "J"))
+		assertx.True(t, tree.Dominates("R", "R"))
+		assertx.False(t, tree.Dominates("G", "I"))
+		assertx.False(t, tree.Dominates("R", "X"))
+		assertx.False(t, tree.Dominates("X", "R"))
+		assertx.Equal(t, tree.Children("C"), []string{"F", "G"})
+		assertx.Nil(t, tree.Children("X"))
+	})
+	t.Run("loop frontiers", func(t *testing.T) {
+		tree, err := createLoopGraph().Dominators("entry")
+		assertx.Nil(t, err)
+		assertx.Equal(t, tree.Nodes(), []string{"entry", "A", "B", "D", "exit", "C"})
+		assertx.Equal(t, immediateDominators(tree), map[string]string{"A": "entry", "B": "A", "C": "A", "D": "A", "exit": "D"})
+		assertx.Equal(t, tree.Frontier("entry"), []string{})
+		assertx.Equal(t, tree.Frontier("A"), []string{"A"})
+		assertx.Equal(t, tree.Frontier("B"), []string{"D"})
+		assertx.Equal(t, tree.Frontier("C"), []string{"D"})
+		assertx.Equal(t, tree.Frontier("D"), []string{"A"})
+		assertx.Equal(t, tree.Frontier("exit"), []string{})
+		assertx.Nil(t, tree.Frontier("missing"))
+	})
+	t.Run("matches removal based dominance on random graphs", func(t *testing.T) {
+		random := rand.New(rand.NewPCG(3, 4))
+		for range 20 {
+			g := New[int]()
+			for i := range 40 {
+				g.AddNode(i)
+			}
+			for range 80 {
+				g.AddEdge(random.IntN(40), random.IntN(40), 1)
+			}
+			tree, err := g.Dominators(0)
+			assertx.Nil(t, err)
+			for _, a := range g.Nodes() {
+				without := g.Clone()
+				without.DeleteNode(a)
+				for _, b := range g.Nodes() {
+					reachable := g.Reachable(0, b)
+					dominated := reachable && (a == b || a == 0 || !without.Reachable(0, b))
+					if tree.Dominates(a, b) != dominated {
+						t.Fatalf("dominates(%d, %d) got: %v, want: %v", a, b, !dominated, dominated)
+					}
+				}
+			}
+		}
+	})
+}
+
+func TestGraph_PostDominators(t *testing.T) {
+	tree, err := createLoopGraph().PostDominators("exit")
+	assertx.Nil(t, err)
+	assertx.Equal(t, immediateDominators(tree), map[string]string{"D": "exit", "A": "D", "B": "D", "C": "D", "entry": "A"})
+	assertx.True(t, tree.Dominates("D", "entry"))
+	assertx.False(t, tree.Dominates("B", "A"))
+	_, err = createLoopGraph().PostDominators("missing")
+	assertx.NotNil(t, err)
+}
+
+func BenchmarkDominators_100(b *testing.B) {
+	g, start, _ := createGridGraph(100)
+	b.ResetTimer()
+	for b.Loop() {
+		g.Dominators(start)
+	}
+}
@@ -0,0 +1,525 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type dotConfig[T comparable] struct {
+	name           string
+	nodeAttributes func(node T) map[string]string
+	edgeAttributes func(from, to T) map[string]string
+}
+
+type DOTOption[T comparable] func(*dotConfig[T])
+
+func WithDOTName[T comparable](name string) DOTOption[T] {
+	return func(c *dotConfig[T]) {
+		c.name = name
+	}
+}
+
+func WithDOTNodeAttributes[T comparable](attributes func(node T) map[string]string) DOTOption[T] {
+	return func(c *dotConfig[T]) {
+		c.nodeAttributes = attributes
+	}
+}
+
+// WithDOTEdgeAttributes adds attributes to every edge, the label attribute is reserved for the weight or
+// a label stored on the edge.
+func WithDOTEdgeAttributes[T comparable](attributes func(from, to T) map[string]string) DOTOption[T] {
+	return func(c *dotConfig[T]) {
+		c.edgeAttributes = attributes
+	}
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language with edge weights as labels. Stored node
+// and edge attributes are written with their default formatting and the attribute options override them,
+// except that a stored label attribute is written in place of the weight.
+func (g *Graph[T, W]) WriteDOT(w io.Writer, options ...DOTOption[T]) error {
+	config := &dotConfig[T]{}
+	for _, option := range options {
+		option(config)
+	}
+	writer := bufio.NewWriter(w)
+	kind, operator := "digraph", "->"
+	if g.undirected {
+		kind, operator = "graph", "--"
+	}
+	writer.WriteString(kind + " ")
+	if config.name != "" {
+		writer.WriteString(quoteDOT(config.name) + " ")
+	}
+	writer.WriteString("{\n")
+	nodes := g.Nodes()
+	for _, node := range nodes {
+		attributes := formatDOTAttributes(g.nodeAttributes[node])
+		if config.nodeAttributes != nil {
+			maps.Copy(attributes, config.nodeAttributes(node))
+		}
+		writer.WriteString("\t" + quoteDOT(fmt.Sprintf("%v", node)))
+		writeDOTAttributes(writer, attributes)
+		writer.WriteString(";\n")
+	}
+	position := positions(nodes)
+	for _, node := range nodes {
+		for _, edge := range g.listedEdges(node, position) {
+			stored := formatDOTAttributes(g.edgeAttributes[edgeKey[T]{from: node, to: edge.Link}])
+			attributes := maps.Clone(stored)
+			if config.edgeAttributes != nil {
+				maps.Copy(attributes, config.edgeAttributes(node, edge.Link))
+			}
+			attributes["label"] = formatWeight(edge.Weight)
+			if label, ok := stored["label"]; ok {
+				attributes["label"] = label
+			}
+			writer.WriteString(fmt.Sprintf("\t%s %s %s", quoteDOT(fmt.Sprintf("%v", node)), operator, quoteDOT(fmt.Sprintf("%v", edge.Link))))
+			writeDOTAttributes(writer, attributes)
+			writer.WriteString(";\n")
+		}
+	}
+	writer.WriteString("}\n")
+	return writer.Flush()
+}
+
+func formatDOTAttributes(stored map[string]any) map[string]string {
+	attributes := make(map[string]string, len(stored))
+	for key, value := range stored {
+		attributes[key] = fmt.Sprintf("%v", value)
+	}
+	return attributes
+}
+
+func writeDOTAttributes(writer *bufio.Writer, attributes map[string]string) {
+	if len(attributes) == 0 {
+		return
+	}
+	writer.WriteString(" [")
+	for i, key := range slices.Sorted(maps.Keys(attributes)) {
+		if i > 0 {
+			writer.WriteString(", ")
+		}
+		writer.WriteString(attributeKeyDOT(key) + "=" + quoteDOT(attributes[key]))
+	}
+	writer.WriteString("]")
+}
+
+func attributeKeyDOT(key string) string {
+	if key == "" {
+		return quoteDOT(key)
+	}
+	for i, r := range key {
+		if !isDOTIdentifier(r, i > 0) {
+			return quoteDOT(key)
+		}
+	}
+	return key
+}
+
+func quoteDOT(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// ReadDOT parses a graph written in the Graphviz DOT language, numeric edge labels become weights.
+// Edges without a label, or whose label is not a valid weight such as text, a fraction read into integer
+// weights or a value out of range, get the zero weight instead of failing the read and keep the label
+// as a string attribute.
+// An undirected DOT graph produces an undirected Graph, a strict one replaces parallel edges and
+// subgraphs are not supported. Other node and edge attributes are stored as string attributes, node
+// and edge defaults apply to the nodes and edges that follow them and graph attributes are ignored.
+func ReadDOT(r io.Reader) (*Graph[string, int], error) {
+	return ReadWeightedDOT[int](r)
+}
+
+func ReadWeightedDOT[W Weight](r io.Reader) (*Graph[string, W], error) {
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := lexDOT(string(input))
+	if err != nil {
+		return nil, err
+	}
+	parser := &dotParser[W]{tokens: tokens}
+	return parser.parse()
+}
+
+type dotTokenKind byte
+
+const (
+	dotEOF dotTokenKind = iota
+	dotID
+	dotPunctuation
+	dotEdgeOperator
+)
+
+type dotToken struct {
+	kind   dotTokenKind
+	value  string
+	quoted bool
+	line   int
+}
+
+func lexDOT(input string) ([]dotToken, error) {
+	tokens := []dotToken{}
+	runes := []rune(input)
+	line := 1
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '\n':
+			line++
+			i++
+		case unicode.IsSpace(r):
+			i++
+		case r == '#' && (i == 0 || runes[i-1] == '\n'):
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '/':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			start := line
+			i += 2
+			for i+1 < len(runes) && (runes[i] != '*' || runes[i+1] != '/') {
+				if runes[i] == '\n' {
+					line++
+				}
+				i++
+			}
+			if i+1 >= len(runes) {
+				return nil, fmt.Errorf("dot: line %d: unterminated comment", start)
+			}
+			i += 2
+		case strings.ContainsRune("{}[];,=:", r):
+			tokens = append(tokens, dotToken{kind: dotPunctuation, value: string(r), line: line})
+			i++
+		case r == '-' && i+1 < len(runes) && (runes[i+1] == '>' || runes[i+1] == '-'):
+			tokens = append(tokens, dotToken{kind: dotEdgeOperator, value: string(runes[i : i+2]), line: line})
+			i += 2
+		case r == '"':
+			builder := strings.Builder{}
+			start := line
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+					i++
+				} else if runes[i] == '\\' && i+1 < len(runes) && runes[i+1] == '\n' {
+					i++
+					line++
+					continue
+				}
+				if runes[i] == '\n' {
+					line++
+				}
+				builder.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("dot: line %d: unterminated string", start)
+			}
+			i++
+			tokens = append(tokens, dotToken{kind: dotID, value: builder.String(), quoted: true, line: start})
+		case r == '<':
+			depth := 0
+			start := i
+			for ; i < len(runes); i++ {
+				if runes[i] == '<' {
+					depth++
+				} else if runes[i] == '>' {
+					depth--
+					if depth == 0 {
+						break
+					}
+				} else if runes[i] == '\n' {
+					line++
+				}
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("dot: line %d: unterminated html string", line)
+			}
+			i++
+			tokens = append(tokens, dotToken{kind: dotID, value: string(runes[start+1 : i-1]), quoted: true, line: line})
+		case isDOTIdentifier(r, false):
+			start := i
+			for i < len(runes) && isDOTIdentifier(runes[i], true) {
+				i++
+			}
+			tokens = append(tokens, dotToken{kind: dotID, value: string(runes[start:i]), line: line})
+		case r == '-' || r == '.' || unicode.IsDigit(r):
+			start := i
+			i++
+			for i < len(runes) && (runes[i] == '.' || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, dotToken{kind: dotID, value: string(runes[start:i]), line: line})
+		default:
+			return nil, fmt.Errorf("dot: line %d: unexpected character %q", line, r)
+		}
+	}
+	return append(tokens, dotToken{kind: dotEOF, line: line}), nil
+}
+
+func isDOTIdentifier(r rune, digits bool) bool {
+	return r == '_' || unicode.IsLetter(r) || r >= 0x80 || (digits && unicode.IsDigit(r))
+}
+
+type dotParser[W Weight] struct {
+	tokens       []dotToken
+	position     int
+	directed     bool
+	edgeDefaults map[string]string
+	nodeDefaults map[string]string
+}
+
+func (p *dotParser[W]) peek() dotToken {
+	return p.tokens[p.position]
+}
+
+func (p *dotParser[W]) next() dotToken {
+	token := p.tokens[p.position]
+	if token.kind != dotEOF {
+		p.position++
+	}
+	return token
+}
+
+func (p *dotParser[W]) isKeyword(token dotToken, keyword string) bool {
+	return token.kind == dotID && !token.quoted && strings.EqualFold(token.value, keyword)
+}
+
+func (p *dotParser[W]) isPunctuation(token dotToken, value string) bool {
+	return token.kind == dotPunctuation && token.value == value
+}
+
+func (p *dotParser[W]) expect(value string) error {
+	token := p.next()
+	if !p.isPunctuation(token, value) {
+		return p.unexpected(token)
+	}
+	return nil
+}
+
+func (p *dotParser[W]) unexpected(token dotToken) error {
+	if token.kind == dotEOF {
+		return fmt.Errorf("dot: line %d: unexpected end of input", token.line)
+	}
+	return fmt.Errorf("dot: line %d: unexpected %q", token.line, token.value)
+}
+
+func (p *dotParser[W]) parse() (*Graph[string, W], error) {
+	options := []Option{}
+	if p.isKeyword(p.peek(), "strict") {
+		p.next()
+		options = append(options, WithEdgePolicy(ReplaceParallelEdges))
+	}
+	token := p.next()
+	switch {
+	case p.isKeyword(token, "digraph"):
+		p.directed = true
+	case p.isKeyword(token, "graph"):
+		p.directed = false
+	default:
+		return nil, p.unexpected(token)
+	}
+	if p.peek().kind == dotID {
+		p.next()
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	if !p.directed {
+		options = append(options, WithUndirected())
+	}
+	g := NewWeighted[string, W](options...)
+	p.edgeDefaults = map[string]string{}
+	p.nodeDefaults = map[string]string{}
+	for {
+		token := p.peek()
+		if p.isPunctuation(token, "}") {
+			p.next()
+			break
+		}
+		if p.isPunctuation(token, ";") {
+			p.next()
+			continue
+		}
+		if err := p.parseStatement(g); err != nil {
+			return nil, err
+		}
+	}
+	if token := p.next(); token.kind != dotEOF {
+		return nil, p.unexpected(token)
+	}
+	return g, nil
+}
+
+func (p *dotParser[W]) parseStatement(g *Graph[string, W]) error {
+	token := p.next()
+	switch {
+	case p.isKeyword(token, "subgraph") || p.isPunctuation(token, "{"):
+		return fmt.Errorf("dot: line %d: subgraphs are not supported", token.line)
+	case p.isKeyword(token, "graph"):
+		_, err := p.parseAttributes()
+		return err
+	case p.isKeyword(token, "node"):
+		attributes, err := p.parseAttributes()
+		if err != nil {
+			return err
+		}
+		maps.Copy(p.nodeDefaults, attributes)
+		return nil
+	case p.isKeyword(token, "edge"):
+		attributes, err := p.parseAttributes()
+		if err != nil {
+			return err
+		}
+		maps.Copy(p.edgeDefaults, attributes)
+		return nil
+	case token.kind != dotID:
+		return p.unexpected(token)
+	}
+	if p.isPunctuation(p.peek(), "=") {
+		p.next()
+		if value := p.next(); value.kind != dotID {
+			return p.unexpected(value)
+		}
+		return nil
+	}
+	nodes := []string{token.value}
+	if err := p.skipPort(); err != nil {
+		return err
+	}
+	for p.peek().kind == dotEdgeOperator {
+		operator := p.next()
+		if (operator.value == "->") != p.directed {
+			return fmt.Errorf("dot: line %d: edge operator %q does not match graph type", operator.line, operator.value)
+		}
+		node := p.next()
+		if p.isKeyword(node, "subgraph") || p.isPunctuation(node, "{") {
+			return fmt.Errorf("dot: line %d: subgraphs are not supported", node.line)
+		}
+		if node.kind != dotID {
+			return p.unexpected(node)
+		}
+		if err := p.skipPort(); err != nil {
+			return err
+		}
+		nodes = append(nodes, node.value)
+	}
+	attributes, err := p.parseAttributes()
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		p.addNode(g, node)
+	}
+	if len(nodes) == 1 {
+		for key, value := range attributes {
+			g.SetNodeAttribute(nodes[0], key, value)
+		}
+		return nil
+	}
+	edgeAttributes := maps.Clone(p.edgeDefaults)
+	maps.Copy(edgeAttributes, attributes)
+	weight, err := parseWeight[W](edgeAttributes["label"])
+	if err != nil {
+		weight = 0
+	} else {
+		delete(edgeAttributes, "label")
+	}
+	for i := 1; i < len(nodes); i++ {
+		g.AddEdge(nodes[i-1], nodes[i], weight)
+		for key, value := range edgeAttributes {
+			g.SetEdgeAttribute(nodes[i-1], nodes[i], key, value)
+		}
+	}
+	return nil
+}
+
+// addNode gives a node the node defaults in effect when it is first mentioned.
+func (p *dotParser[W]) addNode(g *Graph[string, W], node string) {
+	if _, ok := g.adjacency[node]; ok {
+		return
+	}
+	g.AddNode(node)
+	for key, value := range p.nodeDefaults {
+		g.SetNodeAttribute(node, key, value)
+	}
+}
+
+func (p *dotParser[W]) skipPort() error {
+	for p.isPunctuation(p.peek(), ":") {
+		p.next()
+		if token := p.next(); token.kind != dotID {
+			return p.unexpected(token)
+		}
+	}
+	return nil
+}
+
+func (p *dotParser[W]) parseAttributes() (map[string]string, error) {
+	attributes := map[string]string{}
+	for p.isPunctuation(p.peek(), "[") {
+		p.next()
+		for {
+			token := p.next()
+			if p.isPunctuation(token, "]") {
+				break
+			}
+			if token.kind != dotID {
+				return nil, p.unexpected(token)
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			value := p.next()
+			if value.kind != dotID {
+				return nil, p.unexpected(value)
+			}
+			attributes[token.value] = value.value
+			if separator := p.peek(); p.isPunctuation(separator, ",") || p.isPunctuation(separator, ";") {
+				p.next()
+			}
+		}
+	}
+	return attributes, nil
+}
+
+// formatWeight writes weights as plain numbers so types such as time.Duration read back unchanged.
+func formatWeight[W Weight](weight W) string {
+	switch reflect.TypeFor[W]().Kind() {
+	case reflect.Float32:
+		return strconv.FormatFloat(float64(weight), 'g', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(float64(weight), 'g', -1, 64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(uint64(weight), 10)
+	default:
+		return strconv.FormatInt(int64(weight), 10)
+	}
+}
+
+func parseWeight[W Weight](value string) (W, error) {
+	weightType := reflect.TypeFor[W]()
+	switch weightType.Kind() {
+	case reflect.Float32, reflect.Float64:
+		weight, err := strconv.ParseFloat(value, weightType.Bits())
+		return W(weight), err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		weight, err := strconv.ParseUint(value, 10, weightType.Bits())
+		return W(weight), err
+	default:
+		weight, err := strconv.ParseInt(value, 10, weightType.Bits())
+		return W(weight), err
+	}
+}
@@ -0,0 +1,260 @@
+package graph
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func TestGraph_WriteDOT(t *testing.T) {
+	t.Run("empty graph", func(t *testing.T) {
+		g := New[string]()
+		buffer := &bytes.Buffer{}
+		err := g.WriteDOT(buffer)
+		assertx.Nil(t, err)
+		assertx.Equal(t, buffer.String(), "digraph {\n}\n")
+	})
+	t.Run("nodes and weighted edges", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", -2)
+		g.AddNode("D")
+		buffer := &bytes.Buffer{}
+		err := g.WriteDOT(buffer, WithDOTName[string]("deps"))
+		assertx.Nil(t, err)
+		expected := `digraph "deps" {
+	"A";
+	"B";
+	"C";
+	"D";
+	"A" -> "B" [label="1"];
+	"A" -> "C" [label="-2"];
+}
+`
+		assertx.Equal(t, buffer.String(), expected)
+	})
+	t.Run("node and edge attributes are sorted and escaped", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge(`say "hi"`, `C:\dir`, 3)
+		buffer := &bytes.Buffer{}
+		err := g.WriteDOT(buffer,
+			WithDOTNodeAttributes(func(node string) map[string]string {
+				if node == `C:\dir` {
+					return map[string]string{"shape": "box", "color": "red"}
+				}
+				return nil
+			}),
+			WithDOTEdgeAttributes(func(from, to string) map[string]string {
+				return map[string]string{"style": "dashed", "label": "ignored", "tool tip": ""}
+			}),
+		)
+		assertx.Nil(t, err)
+		expected := `digraph {
+	"C:\\dir" [color="red", shape="box"];
+	"say \"hi\"";
+	"say \"hi\"" -> "C:\\dir" [label="3", style="dashed", "tool tip"=""];
+}
+`
+		assertx.Equal(t, buffer.String(), expected)
+	})
+	t.Run("stored attributes are written and options override them", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.SetNodeAttribute("A", "shape", "box")
+		g.SetNodeAttribute("A", "width", 1.5)
+		g.SetEdgeAttribute("A", "B", "color", "red")
+		g.SetEdgeAttribute("A", "B", "label", "depends")
+		g.AddEdge("B", "A", 2)
+		buffer := &bytes.Buffer{}
+		err := g.WriteDOT(buffer, WithDOTNodeAttributes(func(node string) map[string]string {
+			return map[string]string{"shape": "circle"}
+		}), WithDOTEdgeAttributes(func(from, to string) map[string]string {
+			return map[string]string{"label": "ignored"}
+		}))
+		assertx.Nil(t, err)
+		expected := `digraph {
+	"A" [shape="circle", width="1.5"];
+	"B" [shape="circle"];
+	"A" -> "B" [color="red", label="depends"];
+	"B" -> "A" [label="2"];
+}
+`
+		assertx.Equal(t, buffer.String(), expected)
+	})
+	t.Run("write error is returned", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		err := g.WriteDOT(failingWriter{})
+		assertx.NotNil(t, err)
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestReadDOT(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", -2)
+		g.AddEdge(`say "hi"`, `C:\dir`, 3)
+		g.AddNode("D")
+		buffer := &bytes.Buffer{}
+		assertx.Nil(t, g.WriteDOT(buffer))
+		read, err := ReadDOT(buffer)
+		assertx.Nil(t, err)
+		assertx.Equal(t, read.String(), g.String())
+	})
+	t.Run("fixture written by other tools", func(t *testing.T) {
+		input := `/* generated */
+# preprocessor style line
+strict digraph G {
+	graph [rankdir=LR];
+	rankdir = LR
+	node [shape=box]
+	edge [label=5, color=blue];
+	a -> b -> c;
+	b -> d [label="7" style=dashed]
+	d:port:n -> a:s [label=<<b>2</b>>]
+	e [label="not a weight"]; // trailing comment
+	3.5 -> -1 [label=-4]
+	a -> b [label=6 style=bold]
+}`
+		g, err := ReadDOT(strings.NewReader(input))
+		assertx.Nil(t, err)
+		expected := "-1" + "\n" + "3.5 -> -1 (-4)" + "\n" + "a -> b (6)" + "\n" + "b -> c (5), d (7)" + "\n" + "c" + "\n" + "d -> a (0)" + "\n" + "e"
+		assertx.Equal(t, g.String(), expected)
+		assertx.Equal(t, g.NodeAttributes("e"), map[string]any{"label": "not a weight", "shape": "box"})
+		assertx.Equal(t, g.NodeAttributes("a"), map[string]any{"shape": "box"})
+		assertx.Equal(t, g.EdgeAttributes("d", "a"), map[string]any{"color": "blue", "label": "<b>2</b>"})
+		assertx.Equal(t, g.EdgeAttributes("a", "b"), map[string]any{"color": "blue", "style": "bold"})
+		assertx.Equal(t, g.EdgeAttributes("b", "d"), map[string]any{"color": "blue", "style": "dashed"})
+	})
+	t.Run("labels that are not weights round trip", func(t *testing.T) {
+		input := "digraph {\n\t\"a\";\n\t\"b\";\n\t\"c\";\n\t\"a\" -> \"b\" [label=\"depends\"];\n\t\"b\" -> \"c\" [label=\"3\"];\n}\n"
+		g, err := ReadDOT(strings.NewReader(input))
+		assertx.Nil(t, err)
+		buffer := &bytes.Buffer{}
+		assertx.Nil(t, g.WriteDOT(buffer))
+		assertx.Equal(t, buffer.String(), input)
+	})
+	t.Run("node defaults apply to nodes mentioned after them", func(t *testing.T) {
+		g, err := ReadDOT(strings.NewReader(`digraph { a; node [shape=box, color=red]; b [color=blue]; a -> c; node [shape=circle]; d; b }`))
+		assertx.Nil(t, err)
+		assertx.Equal(t, g.NodeAttributes("a"), map[string]any{})
+		assertx.Equal(t, g.NodeAttributes("b"), map[string]any{"shape": "box", "color": "blue"})
+		assertx.Equal(t, g.NodeAttributes("c"), map[string]any{"shape": "box", "color": "red"})
+		assertx.Equal(t, g.NodeAttributes("d"), map[string]any{"shape": "circle", "color": "red"})
+	})
+	t.Run("attributes round trip", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.SetNodeAttribute("A", "shape", "box")
+		g.SetEdgeAttribute("B", "A", "color", "red")
+		buffer := &bytes.Buffer{}
+		assertx.Nil(t, g.WriteDOT(buffer))
+		read, err := ReadDOT(buffer)
+		assertx.Nil(t, err)
+		assertx.Equal(t, read.NodeAttributes("A"), map[string]any{"shape": "box"})
+		assertx.Equal(t, read.EdgeAttributes("A", "B"), map[string]any{"color": "red"})
+		assertx.Equal(t, read.EdgeAttributes("B", "A"), map[string]any{"color": "red"})
+	})
+	t.Run("undirected graph reads as undirected", func(t *testing.T) {
+		g, err := ReadDOT(strings.NewReader(`graph { a -- b [label=2]; c -- c }`))
+		assertx.Nil(t, err)
+		assertx.False(t, g.Directed())
+		assertx.Equal(t, g.String(), "a -- b (2)"+"\n"+"b"+"\n"+"c -- c (0)")
+	})
+	t.Run("undirected round trip", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("C", "B", 2)
+		buffer := &bytes.Buffer{}
+		assertx.Nil(t, g.WriteDOT(buffer))
+		assertx.Equal(t, buffer.String(), "graph {\n\t\"A\";\n\t\"B\";\n\t\"C\";\n\t\"A\" -- \"B\" [label=\"1\"];\n\t\"B\" -- \"C\" [label=\"2\"];\n}\n")
+		read, err := ReadDOT(buffer)
+		assertx.Nil(t, err)
+		assertx.Equal(t, read.String(), g.String())
+	})
+	t.Run("invalid input yields error", func(t *testing.T) {
+		inputs := []string{
+			``,
+			`tree {}`,
+			`digraph {`,
+			`digraph { a -> }`,
+			`digraph { a -- b }`,
+			`graph { a -> b }`,
+			`digraph { a -> b [label] }`,
+			`digraph { a -> b [label=] }`,
+			`digraph { a [ = b] }`,
+			`digraph { a = ; }`,
+			`digraph { a:; }`,
+			`digraph { subgraph x { a } }`,
+			`digraph { { a } }`,
+			`digraph { a -> { b } }`,
+			`digraph { = }`,
+			`digraph { "a }`,
+			`digraph { <a }`,
+			`digraph { /* a }`,
+			`digraph { a @ b }`,
+			`digraph { } extra`,
+		}
+		for _, input := range inputs {
+			g, err := ReadDOT(strings.NewReader(input))
+			assertx.NotNil(t, err)
+			assertx.Nil(t, g)
+		}
+	})
+	t.Run("weighted round trip", func(t *testing.T) {
+		g := NewWeighted[string, float32]()
+		g.AddEdge("A", "B", 0.1)
+		g.AddEdge("B", "C", -2.5)
+		buffer := &bytes.Buffer{}
+		assertx.Nil(t, g.WriteDOT(buffer))
+		assertx.True(t, strings.Contains(buffer.String(), `[label="0.1"]`))
+		read, err := ReadWeightedDOT[float32](buffer)
+		assertx.Nil(t, err)
+		assertx.Equal(t, read.String(), g.String())
+	})
+	t.Run("duration weights read back unchanged", func(t *testing.T) {
+		g := NewWeighted[string, time.Duration]()
+		g.AddEdge("A", "B", time.Minute)
+		buffer := &bytes.Buffer{}
+		assertx.Nil(t, g.WriteDOT(buffer))
+		read, err := ReadWeightedDOT[time.Duration](buffer)
+		assertx.Nil(t, err)
+		edges, _ := read.Neighbours("A")
+		assertx.Equal(t, edges, []Edge[string, time.Duration]{{Link: "B", Weight: time.Minute}})
+	})
+	t.Run("unsigned weights reject negative labels", func(t *testing.T) {
+		g, err := ReadWeightedDOT[uint8](strings.NewReader(`digraph { a -> b [label=200]; b -> c [label=-1]; c -> d [label=300] }`))
+		assertx.Nil(t, err)
+		assertx.Equal(t, g.String(), "a -> b (200)"+"\n"+"b -> c (0)"+"\n"+"c -> d (0)"+"\n"+"d")
+	})
+	t.Run("labels that are not weights fall back to zero", func(t *testing.T) {
+		g, err := ReadDOT(strings.NewReader(`digraph { a -> b [label="depends"]; b -> c [label=2.5]; c -> d }`))
+		assertx.Nil(t, err)
+		assertx.Equal(t, g.String(), "a -> b (0)"+"\n"+"b -> c (0)"+"\n"+"c -> d (0)"+"\n"+"d")
+		assertx.Equal(t, g.EdgeAttributes("a", "b"), map[string]any{"label": "depends"})
+		assertx.Equal(t, g.EdgeAttributes("b", "c"), map[string]any{"label": "2.5"})
+		assertx.Equal(t, g.EdgeAttributes("c", "d"), map[string]any{})
+	})
+	t.Run("read error is returned", func(t *testing.T) {
+		g, err := ReadDOT(failingReader{})
+		assertx.NotNil(t, err)
+		assertx.Nil(t, g)
+	})
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
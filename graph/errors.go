@@ -0,0 +1,50 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrEdgeExists  = errors.New("edge already exists")
+	ErrDirected    = errors.New("graph is directed")
+	ErrUndirected  = errors.New("graph is undirected")
+	ErrNotEulerian = errors.New("graph is not eulerian")
+)
+
+type CycleError[T comparable] struct {
+	Cycle []T
+}
+
+func (e *CycleError[T]) Error() string {
+	return fmt.Sprintf("graph contains cycle for topological sorting: %s", formatCycle(e.Cycle))
+}
+
+type NegativeCycleError[T comparable] struct {
+	Cycle []T
+}
+
+func (e *NegativeCycleError[T]) Error() string {
+	return fmt.Sprintf("graph contains negative cycle: %s", formatCycle(e.Cycle))
+}
+
+func formatCycle[T comparable](cycle []T) string {
+	if len(cycle) == 0 {
+		return ""
+	}
+	builder := strings.Builder{}
+	for _, node := range cycle {
+		builder.WriteString(fmt.Sprintf("%v -> ", node))
+	}
+	builder.WriteString(fmt.Sprintf("%v", cycle[0]))
+	return builder.String()
+}
+
+type OddCycleError[T comparable] struct {
+	Cycle []T
+}
+
+func (e *OddCycleError[T]) Error() string {
+	return fmt.Sprintf("graph is not bipartite, odd cycle: %s", formatCycle(e.Cycle))
+}
@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// EulerianPath uses Hierholzer's algorithm to return a walk using every edge exactly once. Errors wrap
+// ErrNotEulerian and name the degree or connectivity condition that fails.
+func (g *Graph[T, W]) EulerianPath() ([]Arc[T, W], error) {
+	return g.eulerian(false)
+}
+
+// EulerianCircuit returns a walk using every edge exactly once that ends where it starts. Errors wrap
+// ErrNotEulerian and name the degree or connectivity condition that fails.
+func (g *Graph[T, W]) EulerianCircuit() ([]Arc[T, W], error) {
+	return g.eulerian(true)
+}
+
+type eulerLink struct {
+	edge int
+	to   int
+}
+
+type eulerStep struct {
+	node int
+	from int
+	edge int
+}
+
+func (g *Graph[T, W]) eulerian(circuit bool) ([]Arc[T, W], error) {
+	nodes := g.Nodes()
+	position := positions(nodes)
+	edges := g.Edges()
+	links := make([][]eulerLink, len(nodes))
+	in := make([]int, len(nodes))
+	for i, edge := range edges {
+		from, to := position[edge.From], position[edge.To]
+		links[from] = append(links[from], eulerLink{edge: i, to: to})
+		in[to]++
+		if g.undirected && from != to {
+			links[to] = append(links[to], eulerLink{edge: i, to: from})
+			in[from]++
+		}
+	}
+	if len(edges) == 0 {
+		return []Arc[T, W]{}, nil
+	}
+	start, err := g.eulerStart(nodes, links, in, circuit)
+	if err != nil {
+		return nil, err
+	}
+	used := make([]bool, len(edges))
+	next := make([]int, len(nodes))
+	stack := []eulerStep{{node: start, from: -1, edge: -1}}
+	walk := make([]Arc[T, W], 0, len(edges))
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		advanced := false
+		for next[top.node] < len(links[top.node]) {
+			link := links[top.node][next[top.node]]
+			next[top.node]++
+			if used[link.edge] {
+				continue
+			}
+			used[link.edge] = true
+			stack = append(stack, eulerStep{node: link.to, from: top.node, edge: link.edge})
+			advanced = true
+			break
+		}
+		if advanced {
+			continue
+		}
+		stack = stack[:len(stack)-1]
+		if top.edge >= 0 {
+			walk = append(walk, Arc[T, W]{From: nodes[top.from], To: nodes[top.node], Weight: edges[top.edge].Weight})
+		}
+	}
+	if len(walk) < len(edges) {
+		return nil, fmt.Errorf("%w: edges are not all connected", ErrNotEulerian)
+	}
+	slices.Reverse(walk)
+	return walk, nil
+}
+
+// eulerStart checks the degree conditions and picks the node the walk has to start from, the first node
+// with edges when any node would do.
+func (g *Graph[T, W]) eulerStart(nodes []T, links [][]eulerLink, in []int, circuit bool) (int, error) {
+	start := -1
+	for i := range nodes {
+		if len(links[i]) > 0 {
+			start = i
+			break
+		}
+	}
+	if g.undirected {
+		odd := []T{}
+		oddStart := -1
+		for i, node := range nodes {
+			degree := len(links[i])
+			for _, link := range links[i] {
+				if link.to == i {
+					degree++
+				}
+			}
+			if degree%2 == 1 {
+				odd = append(odd, node)
+				if oddStart < 0 {
+					oddStart = i
+				}
+			}
+		}
+		switch {
+		case circuit && len(odd) > 0:
+			return 0, fmt.Errorf("%w: a circuit needs every degree to be even but %v have odd degree", ErrNotEulerian, odd)
+		case len(odd) > 2:
+			return 0, fmt.Errorf("%w: a path needs zero or two nodes of odd degree but %v have odd degree", ErrNotEulerian, odd)
+		case oddStart >= 0:
+			start = oddStart
+		}
+		return start, nil
+	}
+	starts, ends := []T{}, []T{}
+	pathStart := -1
+	for i, node := range nodes {
+		out := len(links[i])
+		switch {
+		case out == in[i]:
+			continue
+		case circuit:
+			return 0, fmt.Errorf("%w: a circuit needs equal in and out degrees but %v has in-degree %d and out-degree %d", ErrNotEulerian, node, in[i], out)
+		case out == in[i]+1:
+			starts = append(starts, node)
+			pathStart = i
+		case in[i] == out+1:
+			ends = append(ends, node)
+		default:
+			return 0, fmt.Errorf("%w: a path needs in and out degrees to differ by at most one but %v has in-degree %d and out-degree %d", ErrNotEulerian, node, in[i], out)
+		}
+	}
+	if len(starts) > 1 || len(ends) > 1 {
+		return 0, fmt.Errorf("%w: a path needs at most one start and one end but %v have an extra outgoing edge and %v an extra incoming edge", ErrNotEulerian, starts, ends)
+	}
+	if pathStart >= 0 {
+		start = pathStart
+	}
+	return start, nil
+}
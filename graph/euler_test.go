@@ -0,0 +1,152 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+// assertEulerian checks that walk is a connected walk using every edge of g exactly once.
+func assertEulerian[T comparable](t *testing.T, g *Graph[T, int], walk []Arc[T, int]) {
+	t.Helper()
+	remaining := g.Clone()
+	for i, arc := range walk {
+		if i > 0 {
+			assertx.Equal(t, arc.From, walk[i-1].To)
+		}
+		assertx.True(t, remaining.removeArc(arc.From, arc.To, arc.Weight))
+	}
+	assertx.Equal(t, remaining.Edges(), []Arc[T, int]{})
+}
+
+func TestGraph_EulerianCircuit(t *testing.T) {
+	t.Run("graph without edges has an empty circuit", func(t *testing.T) {
+		g := New[string]()
+		g.AddNode("A")
+		walk, err := g.EulerianCircuit()
+		assertx.Nil(t, err)
+		assertx.Equal(t, walk, []Arc[string, int]{})
+	})
+	t.Run("directed circuit", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 2)
+		g.AddEdge("C", "A", 3)
+		g.AddEdge("A", "D", 4)
+		g.AddEdge("D", "A", 5)
+		g.AddEdge("B", "B", 6)
+		walk, err := g.EulerianCircuit()
+		assertx.Nil(t, err)
+		assertx.Equal(t, walk, []Arc[string, int]{
+			{From: "A", To: "B", Weight: 1},
+			{From: "B", To: "B", Weight: 6},
+			{From: "B", To: "C", Weight: 2},
+			{From: "C", To: "A", Weight: 3},
+			{From: "A", To: "D", Weight: 4},
+			{From: "D", To: "A", Weight: 5},
+		})
+	})
+	t.Run("undirected circuit with parallel edges and self loop", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "A", 2)
+		g.AddEdge("B", "C", 3)
+		g.AddEdge("C", "D", 4)
+		g.AddEdge("D", "B", 5)
+		g.AddEdge("C", "C", 6)
+		walk, err := g.EulerianCircuit()
+		assertx.Nil(t, err)
+		assertEulerian(t, g, walk)
+		assertx.Equal(t, walk[0].From, walk[len(walk)-1].To)
+	})
+	t.Run("unbalanced directed node yields error", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		walk, err := g.EulerianCircuit()
+		assertx.ErrorIs(t, err, ErrNotEulerian)
+		assertx.Equal(t, err.Error(), "graph is not eulerian: a circuit needs equal in and out degrees but A has in-degree 0 and out-degree 1")
+		assertx.Nil(t, walk)
+	})
+	t.Run("odd undirected degree yields error", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 1)
+		_, err := g.EulerianCircuit()
+		assertx.ErrorIs(t, err, ErrNotEulerian)
+		assertx.Equal(t, err.Error(), "graph is not eulerian: a circuit needs every degree to be even but [A C] have odd degree")
+	})
+	t.Run("disconnected edges yield error", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "A", 1)
+		g.AddEdge("C", "D", 1)
+		g.AddEdge("D", "C", 1)
+		_, err := g.EulerianCircuit()
+		assertx.ErrorIs(t, err, ErrNotEulerian)
+		assertx.Equal(t, err.Error(), "graph is not eulerian: edges are not all connected")
+	})
+}
+
+func TestGraph_EulerianPath(t *testing.T) {
+	t.Run("directed path starts at the node with an extra outgoing edge", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 2)
+		g.AddEdge("C", "B", 3)
+		g.AddEdge("B", "D", 4)
+		g.AddEdge("D", "A", 5)
+		g.AddEdge("D", "E", 6)
+		g.AddEdge("C", "D", 7)
+		walk, err := g.EulerianPath()
+		assertx.Nil(t, err)
+		assertEulerian(t, g, walk)
+		assertx.Equal(t, walk[0].From, "C")
+		assertx.Equal(t, walk[len(walk)-1].To, "E")
+	})
+	t.Run("balanced directed graph gives a circuit", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("B", "A", 1)
+		g.AddEdge("A", "B", 2)
+		walk, err := g.EulerianPath()
+		assertx.Nil(t, err)
+		assertx.Equal(t, walk, []Arc[string, int]{{From: "A", To: "B", Weight: 2}, {From: "B", To: "A", Weight: 1}})
+	})
+	t.Run("undirected path between the odd nodes", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 2)
+		g.AddEdge("C", "A", 3)
+		g.AddEdge("C", "D", 4)
+		walk, err := g.EulerianPath()
+		assertx.Nil(t, err)
+		assertEulerian(t, g, walk)
+		assertx.Equal(t, walk[0].From, "C")
+		assertx.Equal(t, walk[len(walk)-1].To, "D")
+	})
+	t.Run("too many odd nodes yield error", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", 1)
+		g.AddEdge("A", "D", 1)
+		walk, err := g.EulerianPath()
+		assertx.ErrorIs(t, err, ErrNotEulerian)
+		assertx.Equal(t, err.Error(), "graph is not eulerian: a path needs zero or two nodes of odd degree but [A B C D] have odd degree")
+		assertx.Nil(t, walk)
+	})
+	t.Run("degree difference above one yields error", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", 1)
+		_, err := g.EulerianPath()
+		assertx.ErrorIs(t, err, ErrNotEulerian)
+		assertx.Equal(t, err.Error(), "graph is not eulerian: a path needs in and out degrees to differ by at most one but A has in-degree 0 and out-degree 2")
+	})
+	t.Run("several starts yield error", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("C", "D", 1)
+		_, err := g.EulerianPath()
+		assertx.ErrorIs(t, err, ErrNotEulerian)
+		assertx.Equal(t, err.Error(), "graph is not eulerian: a path needs at most one start and one end but [A C] have an extra outgoing edge and [B D] an extra incoming edge")
+	})
+}
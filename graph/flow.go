@@ -0,0 +1,149 @@
+package graph
+
+import "fmt"
+
+// Flow describes a maximum flow together with the minimum cut that limits it.
+// Edges lists every edge of the graph in the order of Graph.Edges with Weight holding the flow it carries,
+// for undirected graphs a negative flow travels from To to From. Cut edges always point from the source
+// side to the sink side.
+type Flow[T comparable, W Weight] struct {
+	Value      W
+	Edges      []Arc[T, W]
+	SourceSide []T
+	SinkSide   []T
+	Cut        []Arc[T, W]
+}
+
+type flowNetwork[W Weight] struct {
+	to       []int
+	capacity []W
+	arcs     [][]int
+	level    []int
+	next     []int
+}
+
+func newFlowNetwork[W Weight](size int) *flowNetwork[W] {
+	return &flowNetwork[W]{
+		arcs:  make([][]int, size),
+		level: make([]int, size),
+		next:  make([]int, size),
+	}
+}
+
+// addArc stores the arc and its residual twin next to each other so arc^1 finds the twin,
+// an undirected edge gives the twin the same capacity.
+func (n *flowNetwork[W]) addArc(from, to int, capacity, reverseCapacity W) {
+	n.arcs[from] = append(n.arcs[from], len(n.to))
+	n.to = append(n.to, to)
+	n.capacity = append(n.capacity, capacity)
+	n.arcs[to] = append(n.arcs[to], len(n.to))
+	n.to = append(n.to, from)
+	n.capacity = append(n.capacity, reverseCapacity)
+}
+
+func (n *flowNetwork[W]) buildLevels(source, sink int) bool {
+	for i := range n.level {
+		n.level[i] = -1
+	}
+	n.level[source] = 0
+	queue := []int{source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, arc := range n.arcs[node] {
+			to := n.to[arc]
+			if n.capacity[arc] > 0 && n.level[to] < 0 {
+				n.level[to] = n.level[node] + 1
+				queue = append(queue, to)
+			}
+		}
+	}
+	return n.level[sink] >= 0
+}
+
+func (n *flowNetwork[W]) push(node, sink int, limit W) W {
+	if node == sink {
+		return limit
+	}
+	for ; n.next[node] < len(n.arcs[node]); n.next[node]++ {
+		arc := n.arcs[node][n.next[node]]
+		to := n.to[arc]
+		if n.capacity[arc] <= 0 || n.level[to] != n.level[node]+1 {
+			continue
+		}
+		if pushed := n.push(to, sink, min(limit, n.capacity[arc])); pushed > 0 {
+			n.capacity[arc] -= pushed
+			n.capacity[arc^1] += pushed
+			return pushed
+		}
+	}
+	return 0
+}
+
+// MaxFlow uses Dinic's algorithm treating edge weights as capacities.
+func (g *Graph[T, W]) MaxFlow(source, sink T) (*Flow[T, W], error) {
+	if _, ok := g.adjacency[source]; !ok {
+		return nil, fmt.Errorf("source node %v not found", source)
+	}
+	if _, ok := g.adjacency[sink]; !ok {
+		return nil, fmt.Errorf("sink node %v not found", sink)
+	}
+	if source == sink {
+		return nil, fmt.Errorf("source and sink must differ, both are %v", source)
+	}
+	nodes := g.Nodes()
+	index := positions(nodes)
+	edges := g.Edges()
+	network := newFlowNetwork[W](len(nodes))
+	var limit W
+	for _, edge := range edges {
+		if edge.Weight < 0 {
+			return nil, fmt.Errorf("edge %v -> %v has negative capacity %v", edge.From, edge.To, edge.Weight)
+		}
+		var reverseCapacity W
+		if g.undirected {
+			reverseCapacity = edge.Weight
+		}
+		network.addArc(index[edge.From], index[edge.To], edge.Weight, reverseCapacity)
+		if edge.From == source || (g.undirected && edge.To == source) {
+			limit += edge.Weight
+		}
+	}
+	s, t := index[source], index[sink]
+	var value W
+	for network.buildLevels(s, t) {
+		clear(network.next)
+		for {
+			pushed := network.push(s, t, limit)
+			if pushed == 0 {
+				break
+			}
+			value += pushed
+		}
+	}
+	flow := &Flow[T, W]{
+		Value:      value,
+		Edges:      make([]Arc[T, W], len(edges)),
+		SourceSide: []T{},
+		SinkSide:   []T{},
+		Cut:        []Arc[T, W]{},
+	}
+	for i, edge := range edges {
+		flow.Edges[i] = Arc[T, W]{From: edge.From, To: edge.To, Weight: edge.Weight - network.capacity[2*i]}
+		fromSource, toSource := network.level[index[edge.From]] >= 0, network.level[index[edge.To]] >= 0
+		switch {
+		case fromSource && !toSource:
+			flow.Cut = append(flow.Cut, edge)
+		case g.undirected && toSource && !fromSource:
+			flow.Cut = append(flow.Cut, Arc[T, W]{From: edge.To, To: edge.From, Weight: edge.Weight})
+		}
+	}
+	for i, node := range nodes {
+		if network.level[i] >= 0 {
+			flow.SourceSide = append(flow.SourceSide, node)
+		} else {
+			flow.SinkSide = append(flow.SinkSide, node)
+		}
+	}
+	return flow, nil
+}
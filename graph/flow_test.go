@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func TestGraph_MaxFlow(t *testing.T) {
+	t.Run("missing source yields error", func(t *testing.T) {
+		g := New[string]()
+		g.AddNode("T")
+		flow, err := g.MaxFlow("S", "T")
+		assertx.NotNil(t, err)
+		assertx.Nil(t, flow)
+	})
+	t.Run("missing sink yields error", func(t *testing.T) {
+		g := New[string]()
+		g.AddNode("S")
+		flow, err := g.MaxFlow("S", "T")
+		assertx.NotNil(t, err)
+		assertx.Nil(t, flow)
+	})
+	t.Run("same source and sink yields error", func(t *testing.T) {
+		g := New[string]()
+		g.AddNode("S")
+		flow, err := g.MaxFlow("S", "S")
+		assertx.NotNil(t, err)
+		assertx.Nil(t, flow)
+	})
+	t.Run("negative capacity yields error", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("S", "T", -1)
+		flow, err := g.MaxFlow("S", "T")
+		assertx.NotNil(t, err)
+		assertx.Nil(t, flow)
+	})
+	t.Run("disconnected sink has no flow", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("S", "A", 3)
+		g.AddNode("T")
+		flow, err := g.MaxFlow("S", "T")
+		assertx.Nil(t, err)
+		assertx.Equal(t, flow.Value, 0)
+		assertx.Equal(t, flow.SourceSide, []string{"A", "S"})
+		assertx.Equal(t, flow.SinkSide, []string{"T"})
+		assertx.Equal(t, flow.Cut, []Arc[string, int]{})
+	})
+	t.Run("max flow matches min cut", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("S", "A", 10)
+		g.AddEdge("S", "C", 10)
+		g.AddEdge("A", "B", 4)
+		g.AddEdge("A", "C", 2)
+		g.AddEdge("A", "D", 8)
+		g.AddEdge("C", "D", 9)
+		g.AddEdge("B", "T", 10)
+		g.AddEdge("D", "B", 6)
+		g.AddEdge("D", "T", 10)
+		flow, err := g.MaxFlow("S", "T")
+		assertx.Nil(t, err)
+		assertx.Equal(t, flow.Value, 19)
+		assertx.Equal(t, flow.SourceSide, []string{"C", "S"})
+		assertx.Equal(t, flow.SinkSide, []string{"A", "B", "D", "T"})
+		assertx.Equal(t, flow.Cut, []Arc[string, int]{
+			{From: "C", To: "D", Weight: 9},
+			{From: "S", To: "A", Weight: 10},
+		})
+		balance := make(map[string]int)
+		for i, edge := range flow.Edges {
+			assertx.True(t, edge.Weight >= 0)
+			assertx.True(t, edge.Weight <= g.Edges()[i].Weight)
+			balance[edge.From] -= edge.Weight
+			balance[edge.To] += edge.Weight
+		}
+		assertx.Equal(t, balance, map[string]int{"S": -19, "A": 0, "B": 0, "C": 0, "D": 0, "T": 19})
+	})
+	t.Run("parallel edges add capacity", func(t *testing.T) {
+		g := New[int]()
+		g.AddEdge(1, 2, 3)
+		g.AddEdge(1, 2, 4)
+		g.AddEdge(2, 3, 10)
+		flow, err := g.MaxFlow(1, 3)
+		assertx.Nil(t, err)
+		assertx.Equal(t, flow.Value, 7)
+		assertx.Equal(t, flow.Edges, []Arc[int, int]{
+			{From: 1, To: 2, Weight: 3},
+			{From: 1, To: 2, Weight: 4},
+			{From: 2, To: 3, Weight: 7},
+		})
+	})
+}
+
+func TestGraph_MaxFlowUndirected(t *testing.T) {
+	g := New[string](WithUndirected())
+	g.AddEdge("S", "A", 3)
+	g.AddEdge("B", "S", 2)
+	g.AddEdge("A", "B", 5)
+	g.AddEdge("T", "A", 1)
+	g.AddEdge("B", "T", 4)
+	flow, err := g.MaxFlow("S", "T")
+	assertx.Nil(t, err)
+	assertx.Equal(t, flow.Value, 5)
+	assertx.Equal(t, flow.SinkSide, []string{"A", "B", "T"})
+	balance := make(map[string]int)
+	for _, edge := range flow.Edges {
+		balance[edge.From] -= edge.Weight
+		balance[edge.To] += edge.Weight
+	}
+	assertx.Equal(t, balance, map[string]int{"S": -5, "A": 0, "B": 0, "T": 5})
+}
+
+func TestGraph_MaxFlowUndirectedCut(t *testing.T) {
+	g := New[string](WithUndirected())
+	g.AddEdge("A", "Z", 5)
+	g.AddEdge("B", "Z", 2)
+	g.AddEdge("A", "B", 1)
+	flow, err := g.MaxFlow("Z", "A")
+	assertx.Nil(t, err)
+	assertx.Equal(t, flow.Value, 6)
+	assertx.Equal(t, flow.SourceSide, []string{"B", "Z"})
+	assertx.Equal(t, flow.SinkSide, []string{"A"})
+	assertx.Equal(t, flow.Cut, []Arc[string, int]{
+		{From: "Z", To: "A", Weight: 5},
+		{From: "B", To: "A", Weight: 1},
+	})
+}
+
+func BenchmarkMaxFlow_100(b *testing.B) {
+	g, start, end := createGridGraph(100)
+	b.ResetTimer()
+	for b.Loop() {
+		g.MaxFlow(start, end)
+	}
+}
@@ -1,54 +1,242 @@
 package graph
 
 import (
-	"errors"
 	"fmt"
-	"math"
+	"maps"
 	"slices"
 	"strings"
 
 	"github.com/salsgithub/godst/heap"
 )
 
-type Edge[T comparable] struct {
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+type Edge[T comparable, W Weight] struct {
 	Link   T
-	Weight int
+	Weight W
+}
+
+// Arc is an edge together with the node it leaves from.
+type Arc[T comparable, W Weight] struct {
+	From   T
+	To     T
+	Weight W
+}
+
+// EdgePolicy decides what AddEdge does when an edge between the same nodes already exists.
+type EdgePolicy byte
+
+const (
+	AllowParallelEdges EdgePolicy = iota
+	ReplaceParallelEdges
+	RejectParallelEdges
+)
+
+type config struct {
+	undirected bool
+	edgePolicy EdgePolicy
+}
+
+type Option func(*config)
+
+// WithUndirected stores every edge in both directions and applies undirected rules to cycles and edge listings.
+func WithUndirected() Option {
+	return func(c *config) {
+		c.undirected = true
+	}
 }
 
-type Graph[T comparable] struct {
-	adjacency map[T][]Edge[T]
+func WithEdgePolicy(policy EdgePolicy) Option {
+	return func(c *config) {
+		c.edgePolicy = policy
+	}
+}
+
+type Graph[T comparable, W Weight] struct {
+	adjacency      map[T][]Edge[T, W]
+	order          []T
+	nodeOrder      func(a, b T) int
+	nodeAttributes map[T]map[string]any
+	edgeAttributes map[edgeKey[T]]map[string]any
+	undirected     bool
+	edgePolicy     EdgePolicy
+}
+
+// New creates a graph with int edge weights, use NewWeighted for any other weight type.
+func New[T comparable](options ...Option) *Graph[T, int] {
+	return NewWeighted[T, int](options...)
+}
+
+func NewWeighted[T comparable, W Weight](options ...Option) *Graph[T, W] {
+	c := &config{}
+	for _, option := range options {
+		option(c)
+	}
+	return &Graph[T, W]{
+		adjacency:      make(map[T][]Edge[T, W]),
+		nodeAttributes: make(map[T]map[string]any),
+		edgeAttributes: make(map[edgeKey[T]]map[string]any),
+		undirected:     c.undirected,
+		edgePolicy:     c.edgePolicy,
+	}
+}
+
+func (g *Graph[T, W]) Directed() bool {
+	return !g.undirected
+}
+
+// AsUndirected copies the graph into an undirected graph, edges between the same pair of nodes
+// collapse into one keeping the weight of the first edge listed by Edges.
+func (g *Graph[T, W]) AsUndirected() *Graph[T, W] {
+	undirected := NewWeighted[T, W](WithUndirected(), WithEdgePolicy(g.edgePolicy))
+	undirected.nodeOrder = g.nodeOrder
+	for _, node := range g.Nodes() {
+		undirected.AddNode(node)
+	}
+	for _, edge := range g.Edges() {
+		if !undirected.HasEdge(edge.From, edge.To) {
+			undirected.AddEdge(edge.From, edge.To, edge.Weight)
+			undirected.copyEdgeAttributes(g, edge.From, edge.To)
+		}
+	}
+	undirected.copyNodeAttributes(g)
+	return undirected
 }
 
-func New[T comparable]() *Graph[T] {
-	return &Graph[T]{
-		adjacency: make(map[T][]Edge[T]),
+// Clone returns an independent copy of the graph with the same options, nodes, edges and attributes.
+// Attribute values themselves are copied shallowly.
+func (g *Graph[T, W]) Clone() *Graph[T, W] {
+	clone := &Graph[T, W]{
+		adjacency:      make(map[T][]Edge[T, W], len(g.adjacency)),
+		order:          slices.Clone(g.order),
+		nodeOrder:      g.nodeOrder,
+		nodeAttributes: make(map[T]map[string]any, len(g.nodeAttributes)),
+		edgeAttributes: make(map[edgeKey[T]]map[string]any, len(g.edgeAttributes)),
+		undirected:     g.undirected,
+		edgePolicy:     g.edgePolicy,
 	}
+	for node, edges := range g.adjacency {
+		clone.adjacency[node] = slices.Clone(edges)
+	}
+	for node, attributes := range g.nodeAttributes {
+		clone.nodeAttributes[node] = maps.Clone(attributes)
+	}
+	for key, attributes := range g.edgeAttributes {
+		clone.edgeAttributes[key] = maps.Clone(attributes)
+	}
+	return clone
 }
 
-func (g *Graph[T]) AddNode(value T) {
+func (g *Graph[T, W]) AddNode(value T) {
 	if _, ok := g.adjacency[value]; ok {
 		return
 	}
-	g.adjacency[value] = []Edge[T]{}
+	g.adjacency[value] = []Edge[T, W]{}
+	g.order = append(g.order, value)
 }
 
-func (g *Graph[T]) AddEdge(from, to T, weight int) {
+// AddEdge returns ErrEdgeExists when the graph rejects parallel edges and the edge is already present.
+func (g *Graph[T, W]) AddEdge(from, to T, weight W) error {
+	if g.edgePolicy != AllowParallelEdges && g.HasEdge(from, to) {
+		if g.edgePolicy == RejectParallelEdges {
+			return fmt.Errorf("%w: %v -> %v", ErrEdgeExists, from, to)
+		}
+		g.SetWeight(from, to, weight)
+		return nil
+	}
 	g.AddNode(from)
 	g.AddNode(to)
-	edge := Edge[T]{
+	edge := Edge[T, W]{
 		Link:   to,
 		Weight: weight,
 	}
 	g.adjacency[from] = append(g.adjacency[from], edge)
+	if g.undirected && from != to {
+		g.adjacency[to] = append(g.adjacency[to], Edge[T, W]{Link: from, Weight: weight})
+	}
+	return nil
 }
 
-func (g *Graph[T]) DeleteNode(value T) {
+// RemoveEdge removes every edge from one node to the other and reports whether any existed.
+func (g *Graph[T, W]) RemoveEdge(from, to T) bool {
+	for _, key := range g.edgeKeys(from, to) {
+		delete(g.edgeAttributes, key)
+	}
+	removed := g.removeLinks(from, to)
+	if g.undirected && from != to {
+		g.removeLinks(to, from)
+	}
+	return removed
+}
+
+func (g *Graph[T, W]) removeLinks(from, to T) bool {
+	edges, ok := g.adjacency[from]
+	if !ok {
+		return false
+	}
+	newEdges := []Edge[T, W]{}
+	for _, edge := range edges {
+		if edge.Link != to {
+			newEdges = append(newEdges, edge)
+		}
+	}
+	g.adjacency[from] = newEdges
+	return len(newEdges) != len(edges)
+}
+
+// SetWeight updates every edge from one node to the other and reports whether any existed.
+func (g *Graph[T, W]) SetWeight(from, to T, weight W) bool {
+	updated := g.setLinkWeight(from, to, weight)
+	if g.undirected && from != to {
+		g.setLinkWeight(to, from, weight)
+	}
+	return updated
+}
+
+func (g *Graph[T, W]) setLinkWeight(from, to T, weight W) bool {
+	updated := false
+	for i, edge := range g.adjacency[from] {
+		if edge.Link == to {
+			g.adjacency[from][i].Weight = weight
+			updated = true
+		}
+	}
+	return updated
+}
+
+func (g *Graph[T, W]) HasEdge(from, to T) bool {
+	_, ok := g.Edge(from, to)
+	return ok
+}
+
+// Edge returns the first edge from one node to the other.
+func (g *Graph[T, W]) Edge(from, to T) (Edge[T, W], bool) {
+	for _, edge := range g.adjacency[from] {
+		if edge.Link == to {
+			return edge, true
+		}
+	}
+	return Edge[T, W]{}, false
+}
+
+func (g *Graph[T, W]) DeleteNode(value T) {
 	if _, ok := g.adjacency[value]; !ok {
 		return
 	}
 	delete(g.adjacency, value)
+	delete(g.nodeAttributes, value)
+	maps.DeleteFunc(g.edgeAttributes, func(key edgeKey[T], _ map[string]any) bool {
+		return key.from == value || key.to == value
+	})
+	g.order = slices.DeleteFunc(g.order, func(node T) bool {
+		return node == value
+	})
 	for node, edges := range g.adjacency {
-		newEdges := []Edge[T]{}
+		newEdges := []Edge[T, W]{}
 		for _, edge := range edges {
 			if edge.Link != value {
 				newEdges = append(newEdges, edge)
@@ -58,7 +246,7 @@ func (g *Graph[T]) DeleteNode(value T) {
 	}
 }
 
-func (g *Graph[T]) Neighbours(value T) ([]Edge[T], bool) {
+func (g *Graph[T, W]) Neighbours(value T) ([]Edge[T, W], bool) {
 	neighbours, ok := g.adjacency[value]
 	return neighbours, ok
 }
@@ -71,42 +259,57 @@ const (
 	visited
 )
 
-func (g *Graph[T]) HasCycle() bool {
-	states := make(map[T]visitedState)
-	for node := range g.adjacency {
-		states[node] = unvisited
-	}
-	for node := range g.adjacency {
-		if states[node] == unvisited {
-			if checkCycle(g, node, states) {
-				return true
-			}
-		}
-	}
-	return false
+func (g *Graph[T, W]) HasCycle() bool {
+	_, ok := g.FindCycle()
+	return ok
 }
 
-func checkCycle[T comparable](g *Graph[T], node T, states map[T]visitedState) bool {
-	states[node] = visiting
-	neighbours, _ := g.Neighbours(node)
-	for _, neighbour := range neighbours {
-		link := neighbour.Link
-		switch states[link] {
-		case visiting:
-			return true
-		case unvisited:
-			if checkCycle(g, link, states) {
-				return true
+// FindCycle returns the nodes of one cycle in the order they are traversed. Undirected graphs skip
+// the edge leading back to the parent once, so parallel edges and self loops still count as cycles.
+func (g *Graph[T, W]) FindCycle() ([]T, bool) {
+	states := make(map[T]visitedState, g.Len())
+	path := []T{}
+	var checkCycle func(node, parent T, hasParent bool) []T
+	checkCycle = func(node, parent T, hasParent bool) []T {
+		states[node] = visiting
+		path = append(path, node)
+		skipped := false
+		for _, edge := range g.adjacency[node] {
+			link := edge.Link
+			if g.undirected && hasParent && !skipped && link == parent {
+				skipped = true
+				continue
+			}
+			switch states[link] {
+			case visiting:
+				return slices.Clone(path[slices.Index(path, link):])
+			case unvisited:
+				if cycle := checkCycle(link, node, true); cycle != nil {
+					return cycle
+				}
 			}
 		}
+		states[node] = visited
+		path = path[:len(path)-1]
+		return nil
 	}
-	states[node] = visited
-	return false
+	for _, node := range g.Nodes() {
+		if states[node] != unvisited {
+			continue
+		}
+		if cycle := checkCycle(node, node, false); cycle != nil {
+			return cycle, true
+		}
+	}
+	return nil, false
 }
 
-func (g *Graph[T]) TopologicalSort() ([]T, error) {
-	if g.HasCycle() {
-		return nil, errors.New("graph contains cycle for topological sorting")
+func (g *Graph[T, W]) TopologicalSort() ([]T, error) {
+	if g.undirected {
+		return nil, fmt.Errorf("%w: topological sort requires a directed graph", ErrUndirected)
+	}
+	if cycle, ok := g.FindCycle(); ok {
+		return nil, &CycleError[T]{Cycle: cycle}
 	}
 	result := make([]T, 0)
 	visited := make(map[T]bool)
@@ -119,178 +322,149 @@ func (g *Graph[T]) TopologicalSort() ([]T, error) {
 				dfs(neighbour.Link)
 			}
 		}
-		result = append([]T{node}, result...)
+		result = append(result, node)
 	}
 	for _, node := range g.Nodes() {
 		if !visited[node] {
 			dfs(node)
 		}
 	}
+	slices.Reverse(result)
 	return result, nil
 }
 
-func (g *Graph[T]) BFS(start T, onVisit func(node T)) error {
+func (g *Graph[T, W]) BFS(start T, onVisit func(node T)) error {
 	if _, ok := g.adjacency[start]; !ok {
 		return fmt.Errorf("start %v not found in graph", start)
 	}
-	queue := []T{start}
-	visited := map[T]bool{
-		start: true,
-	}
-	for len(queue) > 0 {
-		next := queue[0]
-		queue = queue[1:]
-		onVisit(next)
-		neighbours, _ := g.Neighbours(next)
-		for _, neighbour := range neighbours {
-			link := neighbour.Link
-			if !visited[link] {
-				visited[link] = true
-				queue = append(queue, link)
-			}
-		}
+	for visit := range g.BFSSeq(start) {
+		onVisit(visit.Node)
 	}
 	return nil
 }
 
-func (g *Graph[T]) DFS(start T, onVisit func(node T)) error {
+func (g *Graph[T, W]) DFS(start T, onVisit func(node T)) error {
 	if _, ok := g.adjacency[start]; !ok {
 		return fmt.Errorf("start %v not found in graph", start)
 	}
-	queue := []T{start}
-	visited := make(map[T]bool)
-	for len(queue) > 0 {
-		last := len(queue) - 1
-		node := queue[last]
-		queue = queue[:last]
-		if !visited[node] {
-			visited[node] = true
-			onVisit(node)
-			neighbours, _ := g.Neighbours(node)
-			for i := len(neighbours) - 1; i >= 0; i-- {
-				link := neighbours[i].Link
-				if !visited[link] {
-					queue = append(queue, link)
-				}
-			}
-		}
+	for visit := range g.DFSSeq(start) {
+		onVisit(visit.Node)
 	}
 	return nil
 }
 
-type priorityNode[T comparable] struct {
+type priorityNode[T comparable, W Weight] struct {
 	node     T
-	priority int
+	priority W
 }
 
-func (g *Graph[T]) Dijkstra(start, end T) ([]T, int, error) {
+// Dijkstra assumes all edge weights are non-negative, use BellmanFord when they are not.
+func (g *Graph[T, W]) Dijkstra(start, end T) ([]T, W, error) {
 	if _, ok := g.adjacency[start]; !ok {
 		return nil, 0, fmt.Errorf("start node %v not found", start)
 	}
 	if _, ok := g.adjacency[end]; !ok {
 		return nil, 0, fmt.Errorf("end node %v not found", end)
 	}
-	distances := make(map[T]int)
+	distances, route := g.dijkstra(start, func(node T) bool {
+		return node == end
+	}, edgeWeight)
+	distance, ok := distances[end]
+	if !ok {
+		return nil, 0, fmt.Errorf("path from %v to %v not found", start, end)
+	}
+	return buildPath(route, end), distance, nil
+}
+
+func edgeWeight[T comparable, W Weight](_ T, edge Edge[T, W]) (W, bool) {
+	return edge.Weight, true
+}
+
+// dijkstra builds the shortest path tree from start until stop returns true for a settled node,
+// a nil stop explores every reachable node. Edges for which weight reports false are skipped.
+func (g *Graph[T, W]) dijkstra(start T, stop func(node T) bool, weight func(from T, edge Edge[T, W]) (W, bool)) (map[T]W, map[T]T) {
+	distances := map[T]W{start: 0}
 	route := make(map[T]T)
-	queue := heap.New(func(a, b priorityNode[T]) bool {
+	queue := heap.New(func(a, b priorityNode[T, W]) bool {
 		return a.priority < b.priority
 	})
-	for node := range g.adjacency {
-		distances[node] = math.MaxInt
-	}
-	distances[start] = 0
-	queue.Push(priorityNode[T]{node: start, priority: 0})
+	queue.Push(priorityNode[T, W]{node: start, priority: 0})
 	for !queue.IsEmpty() {
 		pop, _ := queue.Pop()
 		node := pop.node
 		if pop.priority > distances[node] {
 			continue
 		}
-		if node == end {
+		if stop != nil && stop(node) {
 			break
 		}
-		neighbours, _ := g.Neighbours(node)
-		for _, neighbour := range neighbours {
-			link := neighbour.Link
-			weight := neighbour.Weight
-			travelDistance := distances[node] + weight
-			if travelDistance < distances[link] {
+		for _, edge := range g.adjacency[node] {
+			edgeWeight, ok := weight(node, edge)
+			if !ok {
+				continue
+			}
+			link := edge.Link
+			travelDistance := distances[node] + edgeWeight
+			if current, ok := distances[link]; !ok || travelDistance < current {
 				distances[link] = travelDistance
 				route[link] = node
-				queue.Push(priorityNode[T]{node: link, priority: travelDistance})
+				queue.Push(priorityNode[T, W]{node: link, priority: travelDistance})
 			}
 		}
 	}
-	if distances[end] == math.MaxInt {
-		return nil, 0, fmt.Errorf("path from %v to %v not found", start, end)
-	}
-	path := []T{}
-	n := end
-	for {
-		path = append([]T{n}, path...)
-		step, ok := route[n]
-		if !ok {
-			break
-		}
-		n = step
-	}
-	return path, distances[end], nil
+	return distances, route
 }
 
-func (g *Graph[T]) AStar(start, end T, heuristic func(a, b T) int) ([]T, int, error) {
+// AStar assumes all edge weights are non-negative, use BellmanFord when they are not.
+func (g *Graph[T, W]) AStar(start, end T, heuristic func(a, b T) W) ([]T, W, error) {
 	if _, ok := g.adjacency[start]; !ok {
 		return nil, 0, fmt.Errorf("start node %v not found", start)
 	}
 	if _, ok := g.adjacency[end]; !ok {
 		return nil, 0, fmt.Errorf("end node %v not found", end)
 	}
-	scoreG := make(map[T]int)
-	scoreF := make(map[T]int)
-	for node := range g.adjacency {
-		scoreG[node] = math.MaxInt
-	}
-	scoreG[start] = 0
+	scoreG := map[T]W{start: 0}
+	scoreF := make(map[T]W)
 	scoreF[start] = heuristic(start, end)
 	route := make(map[T]T)
-	queue := heap.New(func(a, b priorityNode[T]) bool {
+	queue := heap.New(func(a, b priorityNode[T, W]) bool {
 		return a.priority < b.priority
 	})
-	queue.Push(priorityNode[T]{node: start, priority: scoreF[start]})
+	queue.Push(priorityNode[T, W]{node: start, priority: scoreF[start]})
 	for !queue.IsEmpty() {
 		pop, _ := queue.Pop()
 		node := pop.node
 		if node == end {
-			path := []T{}
-			current := end
-			for {
-				path = append([]T{current}, path...)
-				step, ok := route[current]
-				if !ok {
-					break
-				}
-				current = step
-			}
-			return path, scoreG[end], nil
+			return buildPath(route, end), scoreG[end], nil
 		}
 		neighbours, _ := g.Neighbours(node)
 		for _, neighbour := range neighbours {
 			link := neighbour.Link
 			scoreTentative := scoreG[node] + neighbour.Weight
-			if scoreTentative < scoreG[link] {
+			if current, ok := scoreG[link]; !ok || scoreTentative < current {
 				route[link] = node
 				scoreG[link] = scoreTentative
 				scoreF[link] = scoreG[link] + heuristic(link, end)
-				queue.Push(priorityNode[T]{node: link, priority: scoreF[link]})
+				queue.Push(priorityNode[T, W]{node: link, priority: scoreF[link]})
 			}
 		}
 	}
 	return nil, 0, fmt.Errorf("path from %v to %v not found", start, end)
 }
 
-func (g *Graph[T]) Nodes() []T {
-	nodes := make([]T, 0, len(g.adjacency))
-	for node := range g.adjacency {
-		nodes = append(nodes, node)
+// SetNodeOrder makes Nodes, and every method that walks the graph in node order, sort with cmp.
+// Passing nil restores the default order.
+func (g *Graph[T, W]) SetNodeOrder(cmp func(a, b T) int) {
+	g.nodeOrder = cmp
+}
+
+// Nodes returns string, int and float64 nodes in ascending order and any other node type in the
+// order it was added, unless SetNodeOrder supplied a comparator.
+func (g *Graph[T, W]) Nodes() []T {
+	nodes := slices.Clone(g.order)
+	if g.nodeOrder != nil {
+		slices.SortFunc(nodes, g.nodeOrder)
+		return nodes
 	}
 	a := any(nodes)
 	switch t := a.(type) {
@@ -304,23 +478,62 @@ func (g *Graph[T]) Nodes() []T {
 	return nodes
 }
 
-func (g *Graph[T]) Len() int {
+// Edges lists every edge once, undirected edges are listed from the node that comes first in Nodes.
+func (g *Graph[T, W]) Edges() []Arc[T, W] {
+	edges := []Arc[T, W]{}
+	nodes := g.Nodes()
+	position := positions(nodes)
+	for _, node := range nodes {
+		for _, edge := range g.listedEdges(node, position) {
+			edges = append(edges, Arc[T, W]{From: node, To: edge.Link, Weight: edge.Weight})
+		}
+	}
+	return edges
+}
+
+func (g *Graph[T, W]) listedEdges(node T, position map[T]int) []Edge[T, W] {
+	if !g.undirected {
+		return g.adjacency[node]
+	}
+	edges := []Edge[T, W]{}
+	for _, edge := range g.adjacency[node] {
+		if position[edge.Link] >= position[node] {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+func positions[T comparable](nodes []T) map[T]int {
+	position := make(map[T]int, len(nodes))
+	for i, node := range nodes {
+		position[node] = i
+	}
+	return position
+}
+
+func (g *Graph[T, W]) Len() int {
 	return len(g.adjacency)
 }
 
-func (g *Graph[T]) String() string {
+func (g *Graph[T, W]) String() string {
 	if g.Len() == 0 {
 		return ""
 	}
 	builder := strings.Builder{}
 	nodes := g.Nodes()
+	position := positions(nodes)
+	separator := " -> "
+	if g.undirected {
+		separator = " -- "
+	}
 	for i, node := range nodes {
 		builder.WriteString(fmt.Sprintf("%v", node))
-		neighbours := g.adjacency[node]
+		neighbours := g.listedEdges(node, position)
 		if len(neighbours) > 0 {
-			builder.WriteString(" -> ")
+			builder.WriteString(separator)
 			for j, edge := range neighbours {
-				builder.WriteString(fmt.Sprintf("%v (%d)", edge.Link, edge.Weight))
+				builder.WriteString(fmt.Sprintf("%v (%v)", edge.Link, edge.Weight))
 				if j < len(neighbours)-1 {
 					builder.WriteString(", ")
 				}
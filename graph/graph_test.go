@@ -1,8 +1,11 @@
 package graph
 
 import (
+	"cmp"
+	"errors"
 	"math/rand/v2"
 	"testing"
+	"time"
 
 	"github.com/salsgithub/godst/assertx"
 )
@@ -21,6 +24,210 @@ func TestGraph_AddEdge(t *testing.T) {
 	assertx.Equal(t, g.Len(), 2)
 }
 
+func TestGraph_EdgePolicy(t *testing.T) {
+	t.Run("parallel edges are allowed by default", func(t *testing.T) {
+		g := New[string]()
+		assertx.Nil(t, g.AddEdge("A", "B", 1))
+		assertx.Nil(t, g.AddEdge("A", "B", 2))
+		neighbours, _ := g.Neighbours("A")
+		assertx.Equal(t, neighbours, []Edge[string, int]{{Link: "B", Weight: 1}, {Link: "B", Weight: 2}})
+	})
+	t.Run("replace policy updates the existing edge", func(t *testing.T) {
+		g := New[string](WithEdgePolicy(ReplaceParallelEdges), WithUndirected())
+		assertx.Nil(t, g.AddEdge("A", "B", 1))
+		assertx.Nil(t, g.AddEdge("B", "A", 2))
+		assertx.Equal(t, g.Edges(), []Arc[string, int]{{From: "A", To: "B", Weight: 2}})
+	})
+	t.Run("reject policy returns error", func(t *testing.T) {
+		g := New[string](WithEdgePolicy(RejectParallelEdges))
+		assertx.Nil(t, g.AddEdge("A", "B", 1))
+		assertx.Nil(t, g.AddEdge("B", "A", 1))
+		err := g.AddEdge("A", "B", 2)
+		assertx.ErrorIs(t, err, ErrEdgeExists)
+		assertx.Equal(t, g.Edges(), []Arc[string, int]{{From: "A", To: "B", Weight: 1}, {From: "B", To: "A", Weight: 1}})
+	})
+}
+
+func TestGraph_RemoveEdge(t *testing.T) {
+	t.Run("removing missing edge does nothing", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		assertx.False(t, g.RemoveEdge("B", "A"))
+		assertx.False(t, g.RemoveEdge("Z", "A"))
+		assertx.Equal(t, len(g.Edges()), 1)
+	})
+	t.Run("removes every parallel edge but keeps nodes", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "B", 2)
+		g.AddEdge("A", "C", 3)
+		g.AddEdge("B", "A", 4)
+		assertx.True(t, g.RemoveEdge("A", "B"))
+		assertx.Equal(t, g.Len(), 3)
+		assertx.Equal(t, g.Edges(), []Arc[string, int]{{From: "A", To: "C", Weight: 3}, {From: "B", To: "A", Weight: 4}})
+	})
+	t.Run("undirected removes both directions", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "B", 2)
+		assertx.True(t, g.RemoveEdge("B", "A"))
+		assertx.True(t, g.RemoveEdge("B", "B"))
+		assertx.False(t, g.HasEdge("A", "B"))
+		assertx.Equal(t, g.Edges(), []Arc[string, int]{})
+	})
+}
+
+func TestGraph_SetWeight(t *testing.T) {
+	t.Run("setting weight of missing edge does nothing", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		assertx.False(t, g.SetWeight("B", "A", 5))
+		assertx.False(t, g.HasEdge("B", "A"))
+	})
+	t.Run("updates weight", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		assertx.True(t, g.SetWeight("A", "B", 5))
+		edge, ok := g.Edge("A", "B")
+		assertx.True(t, ok)
+		assertx.Equal(t, edge, Edge[string, int]{Link: "B", Weight: 5})
+	})
+	t.Run("undirected updates both directions", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		assertx.True(t, g.SetWeight("B", "A", 5))
+		edge, ok := g.Edge("A", "B")
+		assertx.True(t, ok)
+		assertx.Equal(t, edge.Weight, 5)
+	})
+}
+
+func TestGraph_Edge(t *testing.T) {
+	g := New[string]()
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("A", "B", 2)
+	edge, ok := g.Edge("A", "B")
+	assertx.True(t, ok)
+	assertx.Equal(t, edge, Edge[string, int]{Link: "B", Weight: 1})
+	edge, ok = g.Edge("B", "A")
+	assertx.False(t, ok)
+	assertx.Equal(t, edge, Edge[string, int]{})
+	assertx.True(t, g.HasEdge("A", "B"))
+	assertx.False(t, g.HasEdge("B", "A"))
+}
+
+func TestGraph_Undirected(t *testing.T) {
+	t.Run("graphs are directed by default", func(t *testing.T) {
+		assertx.True(t, New[string]().Directed())
+		assertx.False(t, New[string](WithUndirected()).Directed())
+	})
+	t.Run("adding an edge links both directions", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("C", "C", 2)
+		expected := map[string][]Edge[string, int]{
+			"A": {{Link: "B", Weight: 1}},
+			"B": {{Link: "A", Weight: 1}},
+			"C": {{Link: "C", Weight: 2}},
+		}
+		assertx.Equal(t, g.adjacency, expected)
+	})
+	t.Run("deleting a node removes edges in both directions", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 2)
+		g.DeleteNode("B")
+		expected := map[string][]Edge[string, int]{
+			"A": {},
+			"C": {},
+		}
+		assertx.Equal(t, g.adjacency, expected)
+	})
+	t.Run("tree has no cycle", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("A", "C", 0)
+		g.AddEdge("C", "D", 0)
+		g.AddEdge("X", "Y", 0)
+		assertx.False(t, g.HasCycle())
+	})
+	t.Run("closed path has cycle", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("B", "C", 0)
+		g.AddEdge("C", "A", 0)
+		assertx.True(t, g.HasCycle())
+	})
+	t.Run("parallel edges and self loops are cycles", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("A", "B", 0)
+		assertx.True(t, g.HasCycle())
+		g = New[string](WithUndirected())
+		g.AddEdge("A", "A", 0)
+		assertx.True(t, g.HasCycle())
+	})
+	t.Run("topological sort yields error", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 0)
+		sorted, err := g.TopologicalSort()
+		assertx.ErrorIs(t, err, ErrUndirected)
+		assertx.Nil(t, sorted)
+	})
+	t.Run("edges and string list each edge once", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("B", "A", 1)
+		g.AddEdge("B", "C", 2)
+		g.AddEdge("C", "C", 3)
+		assertx.Equal(t, g.Edges(), []Arc[string, int]{
+			{From: "A", To: "B", Weight: 1},
+			{From: "B", To: "C", Weight: 2},
+			{From: "C", To: "C", Weight: 3},
+		})
+		assertx.Equal(t, g.String(), "A -- B (1)"+"\n"+"B -- C (2)"+"\n"+"C -- C (3)")
+	})
+}
+
+func TestGraph_NewWeighted(t *testing.T) {
+	t.Run("float64 weights", func(t *testing.T) {
+		g := NewWeighted[string, float64]()
+		g.AddEdge("A", "B", 0.5)
+		g.AddEdge("B", "C", 0.25)
+		g.AddEdge("A", "C", 1)
+		path, distance, err := g.Dijkstra("A", "C")
+		assertx.Nil(t, err)
+		assertx.Equal(t, path, []string{"A", "B", "C"})
+		assertx.Equal(t, distance, 0.75)
+		path, distance, err = g.AStar("A", "C", func(a, b string) float64 { return 0 })
+		assertx.Nil(t, err)
+		assertx.Equal(t, path, []string{"A", "B", "C"})
+		assertx.Equal(t, distance, 0.75)
+		assertx.Equal(t, g.String(), "A -> B (0.5), C (1)"+"\n"+"B -> C (0.25)"+"\n"+"C")
+	})
+	t.Run("duration weights", func(t *testing.T) {
+		g := NewWeighted[string, time.Duration](WithUndirected())
+		g.AddEdge("A", "B", time.Second)
+		g.AddEdge("B", "C", 1500*time.Millisecond)
+		path, distance, err := g.Dijkstra("C", "A")
+		assertx.Nil(t, err)
+		assertx.Equal(t, path, []string{"C", "B", "A"})
+		assertx.Equal(t, distance, 2500*time.Millisecond)
+		assertx.Equal(t, g.String(), "A -- B (1s)"+"\n"+"B -- C (1.5s)"+"\n"+"C")
+	})
+}
+
+func TestGraph_Edges(t *testing.T) {
+	g := New[string]()
+	g.AddEdge("B", "A", 1)
+	g.AddEdge("A", "B", 2)
+	g.AddEdge("A", "C", 3)
+	assertx.Equal(t, g.Edges(), []Arc[string, int]{
+		{From: "A", To: "B", Weight: 2},
+		{From: "A", To: "C", Weight: 3},
+		{From: "B", To: "A", Weight: 1},
+	})
+}
+
 func TestGraph_Nodes(t *testing.T) {
 	t.Run("nodes returns sorted values for ints", func(t *testing.T) {
 		g := New[int]()
@@ -44,6 +251,26 @@ func TestGraph_Nodes(t *testing.T) {
 		g.AddEdge(19.71, 20.09, 26)
 		assertx.Equal(t, g.Nodes(), []float64{0.1, 0.2, 10, 19.71, 20, 20.09})
 	})
+	t.Run("nodes returns insertion order for other types", func(t *testing.T) {
+		g := New[coord]()
+		g.AddEdge(coord{x: 2, y: 2}, coord{x: 0, y: 1}, 1)
+		g.AddNode(coord{x: 5, y: 0})
+		g.AddEdge(coord{x: 1, y: 1}, coord{x: 2, y: 2}, 1)
+		g.DeleteNode(coord{x: 0, y: 1})
+		g.AddNode(coord{x: 0, y: 1})
+		assertx.Equal(t, g.Nodes(), []coord{{x: 2, y: 2}, {x: 5, y: 0}, {x: 1, y: 1}, {x: 0, y: 1}})
+	})
+	t.Run("nodes returns values ordered by comparator", func(t *testing.T) {
+		g := New[int64]()
+		g.AddEdge(30, 10, 1)
+		g.AddEdge(20, 30, 1)
+		assertx.Equal(t, g.Nodes(), []int64{30, 10, 20})
+		g.SetNodeOrder(cmp.Compare[int64])
+		assertx.Equal(t, g.Nodes(), []int64{10, 20, 30})
+		assertx.Equal(t, g.AsUndirected().Nodes(), []int64{10, 20, 30})
+		g.SetNodeOrder(nil)
+		assertx.Equal(t, g.Nodes(), []int64{30, 10, 20})
+	})
 }
 
 func TestGraph_DeleteNode(t *testing.T) {
@@ -60,10 +287,10 @@ func TestGraph_DeleteNode(t *testing.T) {
 		g.AddEdge("C", "A", 30)
 		g.DeleteNode("B")
 		assertx.Equal(t, g.Len(), 2)
-		expected := map[string][]Edge[string]{
+		expected := map[string][]Edge[string, int]{
 			"A": {},
 			"C": {
-				Edge[string]{
+				Edge[string, int]{
 					Link:   "A",
 					Weight: 30,
 				},
@@ -80,7 +307,7 @@ func TestGraph_Neighbours(t *testing.T) {
 	g.AddEdge("Spain", "Portugal", 3)
 	neighbours, ok := g.Neighbours("Spain")
 	assertx.True(t, ok)
-	assertx.Equal(t, neighbours, []Edge[string]{
+	assertx.Equal(t, neighbours, []Edge[string, int]{
 		{
 			Link:   "France",
 			Weight: 2,
@@ -146,6 +373,53 @@ func TestGraph_HasCycle(t *testing.T) {
 	})
 }
 
+func TestGraph_FindCycle(t *testing.T) {
+	t.Run("dag has no cycle", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("A", "C", 0)
+		g.AddEdge("B", "C", 0)
+		cycle, ok := g.FindCycle()
+		assertx.False(t, ok)
+		assertx.Nil(t, cycle)
+	})
+	t.Run("self loop", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "A", 0)
+		cycle, ok := g.FindCycle()
+		assertx.True(t, ok)
+		assertx.Equal(t, cycle, []string{"A"})
+	})
+	t.Run("cycle excludes the path leading into it", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("B", "C", 0)
+		g.AddEdge("C", "D", 0)
+		g.AddEdge("D", "B", 0)
+		cycle, ok := g.FindCycle()
+		assertx.True(t, ok)
+		assertx.Equal(t, cycle, []string{"B", "C", "D"})
+	})
+	t.Run("undirected cycle", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("B", "C", 0)
+		g.AddEdge("C", "D", 0)
+		g.AddEdge("D", "B", 0)
+		cycle, ok := g.FindCycle()
+		assertx.True(t, ok)
+		assertx.Equal(t, cycle, []string{"B", "C", "D"})
+	})
+	t.Run("undirected parallel edges", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("A", "B", 0)
+		cycle, ok := g.FindCycle()
+		assertx.True(t, ok)
+		assertx.Equal(t, cycle, []string{"A", "B"})
+	})
+}
+
 func TestGraph_TopologicalSort(t *testing.T) {
 	t.Run("returns error for graph with cycle", func(t *testing.T) {
 		g := New[string]()
@@ -155,6 +429,17 @@ func TestGraph_TopologicalSort(t *testing.T) {
 		assertx.NotNil(t, err)
 		assertx.Nil(t, sorted)
 	})
+	t.Run("cycle error exposes the cycle", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("build", "test", 0)
+		g.AddEdge("test", "lint", 0)
+		g.AddEdge("lint", "test", 0)
+		_, err := g.TopologicalSort()
+		var cycleErr *CycleError[string]
+		assertx.True(t, errors.As(err, &cycleErr))
+		assertx.Equal(t, cycleErr.Cycle, []string{"test", "lint"})
+		assertx.Equal(t, err.Error(), "graph contains cycle for topological sorting: test -> lint -> test")
+	})
 	t.Run("topological sort for DAG", func(t *testing.T) {
 		g := New[string]()
 		g.AddEdge("A", "B", 0)
@@ -410,9 +695,17 @@ func TestGraph_String(t *testing.T) {
 		expected := "A -> B (1), C (2)" + "\n" + "B" + "\n" + "C -> A (3)"
 		assertx.Equal(t, g.String(), expected)
 	})
+	t.Run("graph with unsorted node type follows insertion order", func(t *testing.T) {
+		g := New[int64]()
+		g.AddEdge(3, 1, 1)
+		g.AddEdge(2, 3, 2)
+		for range 10 {
+			assertx.Equal(t, g.String(), "3 -> 1 (1)"+"\n"+"1"+"\n"+"2 -> 3 (2)")
+		}
+	})
 }
 
-func createGridGraph(size int) (*Graph[coord], coord, coord) {
+func createGridGraph(size int) (*Graph[coord, int], coord, coord) {
 	g := New[coord]()
 	for y := range size {
 		for x := range size {
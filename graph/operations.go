@@ -0,0 +1,111 @@
+package graph
+
+// emptyCopy returns a graph without nodes that shares the options and node order of g.
+func (g *Graph[T, W]) emptyCopy() *Graph[T, W] {
+	options := []Option{WithEdgePolicy(g.edgePolicy)}
+	if g.undirected {
+		options = append(options, WithUndirected())
+	}
+	empty := NewWeighted[T, W](options...)
+	empty.nodeOrder = g.nodeOrder
+	return empty
+}
+
+// Transpose returns the graph with every edge reversed, an undirected graph is returned as a copy.
+func (g *Graph[T, W]) Transpose() *Graph[T, W] {
+	if g.undirected {
+		return g.Clone()
+	}
+	transpose := g.emptyCopy()
+	for _, node := range g.Nodes() {
+		transpose.AddNode(node)
+	}
+	for _, edge := range g.Edges() {
+		transpose.AddEdge(edge.To, edge.From, edge.Weight)
+	}
+	for key, attributes := range g.edgeAttributes {
+		for name, value := range attributes {
+			transpose.SetEdgeAttribute(key.to, key.from, name, value)
+		}
+	}
+	transpose.copyNodeAttributes(g)
+	return transpose
+}
+
+// Subgraph returns the graph induced by the given nodes, holding every edge between them. Nodes that
+// are not in the graph are ignored.
+func (g *Graph[T, W]) Subgraph(nodes ...T) *Graph[T, W] {
+	keep := make(map[T]bool, len(nodes))
+	for _, node := range nodes {
+		if _, ok := g.adjacency[node]; ok {
+			keep[node] = true
+		}
+	}
+	subgraph := g.emptyCopy()
+	for _, node := range g.Nodes() {
+		if keep[node] {
+			subgraph.AddNode(node)
+			subgraph.copyNodeAttribute(g, node)
+		}
+	}
+	for _, edge := range g.Edges() {
+		if keep[edge.From] && keep[edge.To] {
+			subgraph.AddEdge(edge.From, edge.To, edge.Weight)
+			subgraph.copyEdgeAttributes(g, edge.From, edge.To)
+		}
+	}
+	return subgraph
+}
+
+// Union returns a graph with the options of g holding the nodes and edges of both graphs. Edges of other
+// are only added between nodes that g does not already connect, and attributes of g take precedence.
+// A directed other is collapsed as by AsUndirected when g is undirected, while an undirected other adds
+// both directions when g is directed.
+func (g *Graph[T, W]) Union(other *Graph[T, W]) *Graph[T, W] {
+	union := g.Clone()
+	for _, node := range other.Nodes() {
+		union.AddNode(node)
+		for name, value := range other.nodeAttributes[node] {
+			if _, ok := union.NodeAttribute(node, name); !ok {
+				union.SetNodeAttribute(node, name, value)
+			}
+		}
+	}
+	source := other
+	if g.undirected && !other.undirected {
+		source = other.AsUndirected()
+	}
+	for _, edge := range source.Edges() {
+		union.addMissingEdge(g, source, edge.From, edge.To, edge.Weight)
+		if !g.undirected && source.undirected && edge.From != edge.To {
+			union.addMissingEdge(g, source, edge.To, edge.From, edge.Weight)
+		}
+	}
+	return union
+}
+
+func (g *Graph[T, W]) addMissingEdge(existing, source *Graph[T, W], from, to T, weight W) {
+	if existing.HasEdge(from, to) {
+		return
+	}
+	g.AddEdge(from, to, weight)
+	g.copyEdgeAttributes(source, from, to)
+}
+
+// Difference returns a graph with every node of g and the edges of g between nodes that other does not
+// connect in the same direction, or in either direction when g is undirected.
+func (g *Graph[T, W]) Difference(other *Graph[T, W]) *Graph[T, W] {
+	difference := g.emptyCopy()
+	for _, node := range g.Nodes() {
+		difference.AddNode(node)
+	}
+	difference.copyNodeAttributes(g)
+	for _, edge := range g.Edges() {
+		if other.HasEdge(edge.From, edge.To) || g.undirected && other.HasEdge(edge.To, edge.From) {
+			continue
+		}
+		difference.AddEdge(edge.From, edge.To, edge.Weight)
+		difference.copyEdgeAttributes(g, edge.From, edge.To)
+	}
+	return difference
+}
@@ -0,0 +1,127 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func createReleaseGraph() *Graph[string, int] {
+	g := New[string]()
+	g.AddEdge("api", "auth", 1)
+	g.AddEdge("api", "db", 2)
+	g.AddEdge("auth", "db", 3)
+	g.AddNode("cli")
+	g.SetNodeAttribute("api", "owner", "web")
+	g.SetEdgeAttribute("api", "db", "protocol", "tcp")
+	return g
+}
+
+func TestGraph_Transpose(t *testing.T) {
+	t.Run("directed edges are reversed", func(t *testing.T) {
+		g := createReleaseGraph()
+		transpose := g.Transpose()
+		assertx.Equal(t, transpose.String(), "api"+"\n"+"auth -> api (1)"+"\n"+"cli"+"\n"+"db -> api (2), auth (3)")
+		assertx.Equal(t, transpose.NodeAttributes("api"), map[string]any{"owner": "web"})
+		assertx.Equal(t, transpose.EdgeAttributes("db", "api"), map[string]any{"protocol": "tcp"})
+		assertx.Equal(t, transpose.EdgeAttributes("api", "db"), map[string]any{})
+		assertx.Equal(t, g.String(), "api -> auth (1), db (2)"+"\n"+"auth -> db (3)"+"\n"+"cli"+"\n"+"db")
+	})
+	t.Run("undirected graph is copied", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		transpose := g.Transpose()
+		transpose.AddEdge("B", "C", 2)
+		assertx.Equal(t, transpose.String(), "A -- B (1)"+"\n"+"B -- C (2)"+"\n"+"C")
+		assertx.Equal(t, g.Len(), 2)
+	})
+}
+
+func TestGraph_Subgraph(t *testing.T) {
+	t.Run("keeps edges between the given nodes", func(t *testing.T) {
+		g := createReleaseGraph()
+		g.AddEdge("api", "db", 5)
+		subgraph := g.Subgraph("db", "api", "missing")
+		assertx.Equal(t, subgraph.String(), "api -> db (2), db (5)"+"\n"+"db")
+		assertx.Equal(t, subgraph.NodeAttributes("api"), map[string]any{"owner": "web"})
+		assertx.Equal(t, subgraph.EdgeAttributes("api", "db"), map[string]any{"protocol": "tcp"})
+		assertx.Equal(t, g.Len(), 4)
+	})
+	t.Run("keeps options", func(t *testing.T) {
+		g := New[string](WithUndirected(), WithEdgePolicy(RejectParallelEdges))
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 2)
+		subgraph := g.Subgraph("A", "B")
+		assertx.False(t, subgraph.Directed())
+		assertx.ErrorIs(t, subgraph.AddEdge("B", "A", 3), ErrEdgeExists)
+		assertx.Equal(t, subgraph.Edges(), []Arc[string, int]{{From: "A", To: "B", Weight: 1}})
+	})
+	t.Run("no nodes gives an empty graph", func(t *testing.T) {
+		assertx.Equal(t, createReleaseGraph().Subgraph().Len(), 0)
+	})
+}
+
+func TestGraph_Union(t *testing.T) {
+	t.Run("edges of both graphs with receiver winning", func(t *testing.T) {
+		g := createReleaseGraph()
+		other := New[string]()
+		other.AddEdge("api", "db", 9)
+		other.AddEdge("api", "cache", 4)
+		other.SetNodeAttribute("api", "owner", "platform")
+		other.SetNodeAttribute("api", "tier", 1)
+		other.SetEdgeAttribute("api", "db", "protocol", "udp")
+		other.SetEdgeAttribute("api", "cache", "protocol", "resp")
+		union := g.Union(other)
+		assertx.Equal(t, union.String(), "api -> auth (1), db (2), cache (4)"+"\n"+"auth -> db (3)"+"\n"+"cache"+"\n"+"cli"+"\n"+"db")
+		assertx.Equal(t, union.NodeAttributes("api"), map[string]any{"owner": "web", "tier": 1})
+		assertx.Equal(t, union.EdgeAttributes("api", "db"), map[string]any{"protocol": "tcp"})
+		assertx.Equal(t, union.EdgeAttributes("api", "cache"), map[string]any{"protocol": "resp"})
+		assertx.Equal(t, g.Len(), 4)
+		assertx.Equal(t, other.Len(), 3)
+	})
+	t.Run("directed other is collapsed into undirected receiver", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		other := New[string]()
+		other.AddEdge("B", "A", 5)
+		other.AddEdge("B", "C", 2)
+		other.AddEdge("C", "B", 3)
+		union := g.Union(other)
+		assertx.Equal(t, union.Edges(), []Arc[string, int]{{From: "A", To: "B", Weight: 1}, {From: "B", To: "C", Weight: 2}})
+	})
+	t.Run("undirected other adds both directions to directed receiver", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		other := New[string](WithUndirected())
+		other.AddEdge("B", "A", 5)
+		other.AddEdge("C", "C", 2)
+		union := g.Union(other)
+		assertx.True(t, union.Directed())
+		assertx.Equal(t, union.String(), "A -> B (1)"+"\n"+"B -> A (5)"+"\n"+"C -> C (2)")
+	})
+}
+
+func TestGraph_Difference(t *testing.T) {
+	t.Run("removes edges present in other", func(t *testing.T) {
+		g := createReleaseGraph()
+		other := New[string]()
+		other.AddEdge("api", "db", 7)
+		other.AddEdge("db", "auth", 1)
+		difference := g.Difference(other)
+		assertx.Equal(t, difference.String(), "api -> auth (1)"+"\n"+"auth -> db (3)"+"\n"+"cli"+"\n"+"db")
+		assertx.Equal(t, difference.NodeAttributes("api"), map[string]any{"owner": "web"})
+		assertx.Equal(t, g.Len(), 4)
+		assertx.True(t, g.HasEdge("api", "db"))
+	})
+	t.Run("undirected receiver ignores direction in other", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 2)
+		g.SetEdgeAttribute("B", "C", "colour", "red")
+		other := New[string]()
+		other.AddEdge("B", "A", 1)
+		difference := g.Difference(other)
+		assertx.Equal(t, difference.Edges(), []Arc[string, int]{{From: "B", To: "C", Weight: 2}})
+		assertx.Equal(t, difference.EdgeAttributes("C", "B"), map[string]any{"colour": "red"})
+	})
+}
@@ -0,0 +1,298 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/salsgithub/godst/heap"
+)
+
+// BellmanFord finds the cheapest path from start to end and supports negative edge weights.
+// A *NegativeCycleError is returned when a negative cycle is reachable from start.
+func (g *Graph[T, W]) BellmanFord(start, end T) ([]T, W, error) {
+	if _, ok := g.adjacency[start]; !ok {
+		return nil, 0, fmt.Errorf("start node %v not found", start)
+	}
+	if _, ok := g.adjacency[end]; !ok {
+		return nil, 0, fmt.Errorf("end node %v not found", end)
+	}
+	distances, route, err := g.bellmanFord(map[T]W{start: 0})
+	if err != nil {
+		return nil, 0, err
+	}
+	distance, ok := distances[end]
+	if !ok {
+		return nil, 0, fmt.Errorf("path from %v to %v not found", start, end)
+	}
+	return buildPath(route, end), distance, nil
+}
+
+func (g *Graph[T, W]) bellmanFord(distances map[T]W) (map[T]W, map[T]T, error) {
+	nodes := g.Nodes()
+	route := make(map[T]T)
+	for range len(nodes) - 1 {
+		changed := false
+		for _, node := range nodes {
+			distance, ok := distances[node]
+			if !ok {
+				continue
+			}
+			for _, edge := range g.adjacency[node] {
+				current, ok := distances[edge.Link]
+				if !ok || distance+edge.Weight < current {
+					distances[edge.Link] = distance + edge.Weight
+					route[edge.Link] = node
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			return distances, route, nil
+		}
+	}
+	for _, node := range nodes {
+		distance, ok := distances[node]
+		if !ok {
+			continue
+		}
+		for _, edge := range g.adjacency[node] {
+			if current, ok := distances[edge.Link]; !ok || distance+edge.Weight < current {
+				route[edge.Link] = node
+				return nil, nil, &NegativeCycleError[T]{Cycle: negativeCycle(route, edge.Link, len(nodes))}
+			}
+		}
+	}
+	return distances, route, nil
+}
+
+// negativeCycle walks the route back far enough to be inside the cycle and then collects it.
+func negativeCycle[T comparable](route map[T]T, node T, size int) []T {
+	for range size {
+		previous, ok := route[node]
+		if !ok {
+			break
+		}
+		node = previous
+	}
+	cycle := []T{node}
+	for current := route[node]; current != node; current = route[current] {
+		cycle = append(cycle, current)
+	}
+	slices.Reverse(cycle)
+	return cycle
+}
+
+func buildPath[T comparable](route map[T]T, end T) []T {
+	path := []T{end}
+	for {
+		step, ok := route[path[len(path)-1]]
+		if !ok {
+			break
+		}
+		path = append(path, step)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// AllPairs holds the shortest distances between every pair of nodes, unreachable pairs are absent.
+type AllPairs[T comparable, W Weight] struct {
+	Distances map[T]map[T]W
+	previous  map[T]map[T]T
+}
+
+func (a *AllPairs[T, W]) Distance(from, to T) (W, bool) {
+	distance, ok := a.Distances[from][to]
+	return distance, ok
+}
+
+func (a *AllPairs[T, W]) Path(from, to T) ([]T, bool) {
+	if _, ok := a.Distances[from][to]; !ok {
+		return nil, false
+	}
+	route := a.previous[from]
+	path := []T{to}
+	for node := to; node != from; {
+		node = route[node]
+		path = append(path, node)
+	}
+	slices.Reverse(path)
+	return path, true
+}
+
+// FloydWarshall computes all pairs shortest paths in O(V³) which suits dense graphs.
+// A *NegativeCycleError is returned when the graph contains a negative cycle.
+func (g *Graph[T, W]) FloydWarshall() (*AllPairs[T, W], error) {
+	nodes := g.Nodes()
+	distances := make(map[T]map[T]W, len(nodes))
+	previous := make(map[T]map[T]T, len(nodes))
+	for _, node := range nodes {
+		distances[node] = map[T]W{node: 0}
+		previous[node] = make(map[T]T)
+	}
+	for _, node := range nodes {
+		for _, edge := range g.adjacency[node] {
+			if current, ok := distances[node][edge.Link]; !ok || edge.Weight < current {
+				distances[node][edge.Link] = edge.Weight
+				previous[node][edge.Link] = node
+			}
+		}
+	}
+	for _, k := range nodes {
+		for _, i := range nodes {
+			ik, ok := distances[i][k]
+			if !ok {
+				continue
+			}
+			for _, j := range nodes {
+				kj, ok := distances[k][j]
+				if !ok {
+					continue
+				}
+				if current, ok := distances[i][j]; !ok || ik+kj < current {
+					distances[i][j] = ik + kj
+					previous[i][j] = previous[k][j]
+				}
+			}
+		}
+	}
+	for _, node := range nodes {
+		if distances[node][node] < 0 {
+			_, _, err := g.bellmanFord(zeroDistances[T, W](nodes))
+			return nil, err
+		}
+	}
+	return &AllPairs[T, W]{Distances: distances, previous: previous}, nil
+}
+
+// Johnson computes all pairs shortest paths by reweighting edges with Bellman-Ford potentials and
+// running Dijkstra from every node, which suits sparse graphs with negative edges.
+// A *NegativeCycleError is returned when the graph contains a negative cycle.
+func (g *Graph[T, W]) Johnson() (*AllPairs[T, W], error) {
+	nodes := g.Nodes()
+	potentials, _, err := g.bellmanFord(zeroDistances[T, W](nodes))
+	if err != nil {
+		return nil, err
+	}
+	reweight := func(from T, edge Edge[T, W]) (W, bool) {
+		return edge.Weight + potentials[from] - potentials[edge.Link], true
+	}
+	distances := make(map[T]map[T]W, len(nodes))
+	previous := make(map[T]map[T]T, len(nodes))
+	for _, node := range nodes {
+		reweighted, route := g.dijkstra(node, nil, reweight)
+		row := make(map[T]W, len(reweighted))
+		for target, distance := range reweighted {
+			row[target] = distance - potentials[node] + potentials[target]
+		}
+		distances[node] = row
+		previous[node] = route
+	}
+	return &AllPairs[T, W]{Distances: distances, previous: previous}, nil
+}
+
+// zeroDistances starts every node at zero which behaves like a virtual source linked to all nodes.
+func zeroDistances[T comparable, W Weight](nodes []T) map[T]W {
+	distances := make(map[T]W, len(nodes))
+	for _, node := range nodes {
+		distances[node] = 0
+	}
+	return distances
+}
+
+type Path[T comparable, W Weight] struct {
+	Nodes []T
+	Cost  W
+}
+
+type pathEdge[T comparable] struct {
+	from T
+	to   T
+}
+
+// KShortestPaths uses Yen's algorithm to find up to k loopless paths from start to end ordered by cost.
+// Like Dijkstra it assumes all edge weights are non-negative.
+func (g *Graph[T, W]) KShortestPaths(start, end T, k int) ([]Path[T, W], error) {
+	if _, ok := g.adjacency[start]; !ok {
+		return nil, fmt.Errorf("start node %v not found", start)
+	}
+	if _, ok := g.adjacency[end]; !ok {
+		return nil, fmt.Errorf("end node %v not found", end)
+	}
+	stop := func(node T) bool {
+		return node == end
+	}
+	distances, route := g.dijkstra(start, stop, edgeWeight)
+	if _, ok := distances[end]; !ok {
+		return nil, fmt.Errorf("path from %v to %v not found", start, end)
+	}
+	paths := []Path[T, W]{{Nodes: buildPath(route, end), Cost: distances[end]}}
+	candidates := heap.New(func(a, b Path[T, W]) bool {
+		if a.Cost == b.Cost {
+			return len(a.Nodes) < len(b.Nodes)
+		}
+		return a.Cost < b.Cost
+	})
+	for len(paths) < k {
+		previous := paths[len(paths)-1].Nodes
+		for i := range len(previous) - 1 {
+			spur := previous[i]
+			root := previous[:i+1]
+			removedEdges := make(map[pathEdge[T]]bool)
+			for _, path := range paths {
+				if len(path.Nodes) > i+1 && slices.Equal(path.Nodes[:i+1], root) {
+					removedEdges[pathEdge[T]{from: path.Nodes[i], to: path.Nodes[i+1]}] = true
+				}
+			}
+			removedNodes := make(map[T]bool, i)
+			for _, node := range root[:i] {
+				removedNodes[node] = true
+			}
+			distances, route := g.dijkstra(spur, stop, func(from T, edge Edge[T, W]) (W, bool) {
+				if removedNodes[edge.Link] || removedEdges[pathEdge[T]{from: from, to: edge.Link}] {
+					return 0, false
+				}
+				return edge.Weight, true
+			})
+			if _, ok := distances[end]; !ok {
+				continue
+			}
+			nodes := append(slices.Clone(root[:i]), buildPath(route, end)...)
+			candidates.Push(Path[T, W]{Nodes: nodes, Cost: g.pathCost(root) + distances[end]})
+		}
+		next, ok := nextCandidate(candidates, paths)
+		if !ok {
+			break
+		}
+		paths = append(paths, next)
+	}
+	return paths[:min(len(paths), max(k, 0))], nil
+}
+
+func nextCandidate[T comparable, W Weight](candidates *heap.Heap[Path[T, W]], paths []Path[T, W]) (Path[T, W], bool) {
+	for !candidates.IsEmpty() {
+		candidate, _ := candidates.Pop()
+		duplicate := slices.ContainsFunc(paths, func(path Path[T, W]) bool {
+			return slices.Equal(path.Nodes, candidate.Nodes)
+		})
+		if !duplicate {
+			return candidate, true
+		}
+	}
+	return Path[T, W]{}, false
+}
+
+// pathCost sums the cheapest edge between each consecutive pair of nodes.
+func (g *Graph[T, W]) pathCost(nodes []T) W {
+	var cost W
+	for i := 1; i < len(nodes); i++ {
+		cheapest, found := W(0), false
+		for _, edge := range g.adjacency[nodes[i-1]] {
+			if edge.Link == nodes[i] && (!found || edge.Weight < cheapest) {
+				cheapest, found = edge.Weight, true
+			}
+		}
+		cost += cheapest
+	}
+	return cost
+}
@@ -0,0 +1,282 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func TestGraph_BellmanFord(t *testing.T) {
+	t.Run("bellman ford on empty graph yields error", func(t *testing.T) {
+		g := New[string]()
+		path, distance, err := g.BellmanFord("start", "end")
+		assertx.NotNil(t, err)
+		assertx.Nil(t, path)
+		assertx.Equal(t, distance, 0)
+	})
+	t.Run("end node not present in graph", func(t *testing.T) {
+		g := New[string]()
+		g.AddNode("A")
+		path, distance, err := g.BellmanFord("A", "Z")
+		assertx.NotNil(t, err)
+		assertx.Nil(t, path)
+		assertx.Equal(t, distance, 0)
+	})
+	t.Run("returns error for an unreachable node", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddNode("Z")
+		path, distance, err := g.BellmanFord("A", "Z")
+		assertx.NotNil(t, err)
+		assertx.Nil(t, path)
+		assertx.Equal(t, distance, 0)
+	})
+	t.Run("path to start is the start node", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		path, distance, err := g.BellmanFord("A", "A")
+		assertx.Nil(t, err)
+		assertx.Equal(t, path, []string{"A"})
+		assertx.Equal(t, distance, 0)
+	})
+	t.Run("matches dijkstra for non negative weights", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", 4)
+		g.AddEdge("B", "C", 1)
+		g.AddEdge("B", "D", 5)
+		g.AddEdge("C", "D", 2)
+		path, distance, err := g.BellmanFord("A", "D")
+		assertx.Nil(t, err)
+		assertx.Equal(t, path, []string{"A", "B", "C", "D"})
+		assertx.Equal(t, distance, 4)
+	})
+	t.Run("negative weights find cheaper path", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 4)
+		g.AddEdge("A", "C", 2)
+		g.AddEdge("B", "D", -3)
+		g.AddEdge("C", "D", 1)
+		path, distance, err := g.BellmanFord("A", "D")
+		assertx.Nil(t, err)
+		assertx.Equal(t, path, []string{"A", "B", "D"})
+		assertx.Equal(t, distance, 1)
+	})
+	t.Run("reachable negative cycle yields typed error with cycle", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 2)
+		g.AddEdge("C", "D", -4)
+		g.AddEdge("D", "B", 1)
+		g.AddEdge("D", "E", 1)
+		path, distance, err := g.BellmanFord("A", "E")
+		assertx.Nil(t, path)
+		assertx.Equal(t, distance, 0)
+		var cycleErr *NegativeCycleError[string]
+		assertx.True(t, errors.As(err, &cycleErr))
+		assertx.Equal(t, len(cycleErr.Cycle), 3)
+		assertx.True(t, isCycle(g, cycleErr.Cycle))
+	})
+	t.Run("unreachable negative cycle is ignored", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("X", "Y", -2)
+		g.AddEdge("Y", "X", 1)
+		path, distance, err := g.BellmanFord("A", "B")
+		assertx.Nil(t, err)
+		assertx.Equal(t, path, []string{"A", "B"})
+		assertx.Equal(t, distance, 1)
+	})
+}
+
+func TestNegativeCycleError_Error(t *testing.T) {
+	err := &NegativeCycleError[string]{Cycle: []string{"A", "B", "C"}}
+	assertx.Equal(t, err.Error(), "graph contains negative cycle: A -> B -> C -> A")
+}
+
+func TestGraph_AllPairs(t *testing.T) {
+	algorithms := map[string]func(g *Graph[string, int]) (*AllPairs[string, int], error){
+		"floyd warshall": (*Graph[string, int]).FloydWarshall,
+		"johnson":        (*Graph[string, int]).Johnson,
+	}
+	for name, allPairs := range algorithms {
+		t.Run(name+" on empty graph yields empty distances", func(t *testing.T) {
+			g := New[string]()
+			result, err := allPairs(g)
+			assertx.Nil(t, err)
+			assertx.Equal(t, len(result.Distances), 0)
+		})
+		t.Run(name+" computes distances and paths", func(t *testing.T) {
+			g := New[string]()
+			g.AddEdge("A", "B", 4)
+			g.AddEdge("A", "C", 2)
+			g.AddEdge("B", "D", -3)
+			g.AddEdge("C", "D", 1)
+			g.AddEdge("D", "E", 2)
+			g.AddNode("Z")
+			result, err := allPairs(g)
+			assertx.Nil(t, err)
+			assertx.Equal(t, result.Distances["A"], map[string]int{"A": 0, "B": 4, "C": 2, "D": 1, "E": 3})
+			assertx.Equal(t, result.Distances["D"], map[string]int{"D": 0, "E": 2})
+			distance, ok := result.Distance("B", "E")
+			assertx.True(t, ok)
+			assertx.Equal(t, distance, -1)
+			path, ok := result.Path("A", "E")
+			assertx.True(t, ok)
+			assertx.Equal(t, path, []string{"A", "B", "D", "E"})
+			path, ok = result.Path("C", "C")
+			assertx.True(t, ok)
+			assertx.Equal(t, path, []string{"C"})
+		})
+		t.Run(name+" unreachable pairs are absent", func(t *testing.T) {
+			g := New[string]()
+			g.AddEdge("A", "B", 1)
+			g.AddNode("Z")
+			result, err := allPairs(g)
+			assertx.Nil(t, err)
+			_, ok := result.Distance("A", "Z")
+			assertx.False(t, ok)
+			path, ok := result.Path("B", "A")
+			assertx.False(t, ok)
+			assertx.Nil(t, path)
+		})
+		t.Run(name+" negative cycle yields typed error", func(t *testing.T) {
+			g := New[string]()
+			g.AddEdge("A", "B", 1)
+			g.AddEdge("B", "C", -3)
+			g.AddEdge("C", "A", 1)
+			result, err := allPairs(g)
+			assertx.Nil(t, result)
+			var cycleErr *NegativeCycleError[string]
+			assertx.True(t, errors.As(err, &cycleErr))
+			assertx.True(t, isCycle(g, cycleErr.Cycle))
+		})
+	}
+	t.Run("floyd warshall and johnson agree with dijkstra", func(t *testing.T) {
+		g, _, _ := createGridGraph(6)
+		floyd, err := g.FloydWarshall()
+		assertx.Nil(t, err)
+		johnson, err := g.Johnson()
+		assertx.Nil(t, err)
+		assertx.Equal(t, floyd.Distances, johnson.Distances)
+		for _, from := range g.Nodes() {
+			for _, to := range g.Nodes() {
+				_, want, err := g.Dijkstra(from, to)
+				got, ok := johnson.Distance(from, to)
+				assertx.Equal(t, ok, err == nil)
+				assertx.Equal(t, got, want)
+			}
+		}
+	})
+}
+
+func TestGraph_KShortestPaths(t *testing.T) {
+	g := New[string]()
+	g.AddEdge("C", "D", 3)
+	g.AddEdge("C", "E", 2)
+	g.AddEdge("D", "F", 4)
+	g.AddEdge("E", "D", 1)
+	g.AddEdge("E", "F", 2)
+	g.AddEdge("E", "G", 3)
+	g.AddEdge("F", "G", 2)
+	g.AddEdge("F", "H", 1)
+	g.AddEdge("G", "H", 2)
+	t.Run("missing start yields error", func(t *testing.T) {
+		paths, err := g.KShortestPaths("Z", "H", 3)
+		assertx.NotNil(t, err)
+		assertx.Nil(t, paths)
+	})
+	t.Run("missing end yields error", func(t *testing.T) {
+		paths, err := g.KShortestPaths("C", "Z", 3)
+		assertx.NotNil(t, err)
+		assertx.Nil(t, paths)
+	})
+	t.Run("unreachable end yields error", func(t *testing.T) {
+		paths, err := g.KShortestPaths("H", "C", 3)
+		assertx.NotNil(t, err)
+		assertx.Nil(t, paths)
+	})
+	t.Run("zero paths requested", func(t *testing.T) {
+		paths, err := g.KShortestPaths("C", "H", 0)
+		assertx.Nil(t, err)
+		assertx.Equal(t, paths, []Path[string, int]{})
+	})
+	t.Run("first path matches dijkstra", func(t *testing.T) {
+		paths, err := g.KShortestPaths("C", "H", 1)
+		assertx.Nil(t, err)
+		nodes, cost, _ := g.Dijkstra("C", "H")
+		assertx.Equal(t, paths, []Path[string, int]{{Nodes: nodes, Cost: cost}})
+	})
+	t.Run("paths are ranked by cost", func(t *testing.T) {
+		paths, err := g.KShortestPaths("C", "H", 3)
+		assertx.Nil(t, err)
+		assertx.Equal(t, paths, []Path[string, int]{
+			{Nodes: []string{"C", "E", "F", "H"}, Cost: 5},
+			{Nodes: []string{"C", "E", "G", "H"}, Cost: 7},
+			{Nodes: []string{"C", "D", "F", "H"}, Cost: 8},
+		})
+	})
+	t.Run("returns every path when fewer than k exist", func(t *testing.T) {
+		paths, err := g.KShortestPaths("C", "H", 100)
+		assertx.Nil(t, err)
+		assertx.Equal(t, len(paths), 7)
+		for i := 1; i < len(paths); i++ {
+			assertx.True(t, paths[i-1].Cost <= paths[i].Cost)
+		}
+	})
+	t.Run("parallel edges use the cheapest weight", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 5)
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 1)
+		g.AddEdge("A", "C", 3)
+		paths, err := g.KShortestPaths("A", "C", 3)
+		assertx.Nil(t, err)
+		assertx.Equal(t, paths, []Path[string, int]{
+			{Nodes: []string{"A", "B", "C"}, Cost: 2},
+			{Nodes: []string{"A", "C"}, Cost: 3},
+		})
+	})
+}
+
+func isCycle[T comparable, W Weight](g *Graph[T, W], cycle []T) bool {
+	for i, node := range cycle {
+		next := cycle[(i+1)%len(cycle)]
+		neighbours, _ := g.Neighbours(node)
+		found := false
+		for _, edge := range neighbours {
+			if edge.Link == next {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func BenchmarkFloydWarshall_20(b *testing.B) {
+	g, _, _ := createGridGraph(20)
+	b.ResetTimer()
+	for b.Loop() {
+		g.FloydWarshall()
+	}
+}
+
+func BenchmarkJohnson_20(b *testing.B) {
+	g, _, _ := createGridGraph(20)
+	b.ResetTimer()
+	for b.Loop() {
+		g.Johnson()
+	}
+}
+
+func BenchmarkKShortestPaths_20(b *testing.B) {
+	g, start, end := createGridGraph(20)
+	b.ResetTimer()
+	for b.Loop() {
+		g.KShortestPaths(start, end, 10)
+	}
+}
@@ -0,0 +1,71 @@
+package graph
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/salsgithub/godst/heap"
+	"github.com/salsgithub/godst/unionfind"
+)
+
+// Kruskal returns the edges and total weight of a minimum spanning forest, edge direction is ignored.
+func (g *Graph[T, W]) Kruskal() ([]Arc[T, W], W) {
+	edges := g.Edges()
+	slices.SortStableFunc(edges, func(a, b Arc[T, W]) int {
+		return cmp.Compare(a.Weight, b.Weight)
+	})
+	forest := unionfind.New(g.Nodes()...)
+	tree := []Arc[T, W]{}
+	var total W
+	for _, edge := range edges {
+		if forest.Union(edge.From, edge.To) {
+			tree = append(tree, edge)
+			total += edge.Weight
+		}
+	}
+	return tree, total
+}
+
+// Prim returns the edges and total weight of a minimum spanning forest, edge direction is ignored.
+func (g *Graph[T, W]) Prim() ([]Arc[T, W], W) {
+	incident := make(map[T][]Arc[T, W], g.Len())
+	for _, edge := range g.Edges() {
+		if edge.From == edge.To {
+			continue
+		}
+		incident[edge.From] = append(incident[edge.From], edge)
+		incident[edge.To] = append(incident[edge.To], edge)
+	}
+	visited := make(map[T]bool, g.Len())
+	queue := heap.New(func(a, b Arc[T, W]) bool {
+		return a.Weight < b.Weight
+	})
+	tree := []Arc[T, W]{}
+	var total W
+	visit := func(node T) {
+		visited[node] = true
+		for _, edge := range incident[node] {
+			queue.Push(edge)
+		}
+	}
+	for _, root := range g.Nodes() {
+		if visited[root] {
+			continue
+		}
+		visit(root)
+		for !queue.IsEmpty() {
+			edge, _ := queue.Pop()
+			if visited[edge.From] && visited[edge.To] {
+				continue
+			}
+			tree = append(tree, edge)
+			total += edge.Weight
+			if visited[edge.From] {
+				visit(edge.To)
+			} else {
+				visit(edge.From)
+			}
+		}
+	}
+	return tree, total
+}
@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func TestGraph_MinimumSpanningTree(t *testing.T) {
+	algorithms := map[string]func(g *Graph[string, int]) ([]Arc[string, int], int){
+		"kruskal": (*Graph[string, int]).Kruskal,
+		"prim":    (*Graph[string, int]).Prim,
+	}
+	for name, spanningTree := range algorithms {
+		t.Run(name+" on empty graph yields no edges", func(t *testing.T) {
+			g := New[string]()
+			edges, total := spanningTree(g)
+			assertx.Equal(t, len(edges), 0)
+			assertx.Equal(t, total, 0)
+		})
+		t.Run(name+" finds minimum spanning tree", func(t *testing.T) {
+			g := New[string]()
+			g.AddEdge("A", "B", 4)
+			g.AddEdge("A", "C", 1)
+			g.AddEdge("C", "B", 2)
+			g.AddEdge("B", "D", 5)
+			g.AddEdge("C", "D", 8)
+			g.AddEdge("D", "E", 3)
+			g.AddEdge("E", "E", -10)
+			/*
+				A --4-- B
+				|     / |
+				1    2  5
+				|  /    |
+				C --8-- D --3-- E
+			*/
+			edges, total := spanningTree(g)
+			assertx.Equal(t, total, 11)
+			assertx.Equal(t, len(edges), 4)
+			assertx.True(t, slices.Contains(edges, Arc[string, int]{From: "A", To: "C", Weight: 1}))
+			assertx.True(t, slices.Contains(edges, Arc[string, int]{From: "C", To: "B", Weight: 2}))
+			assertx.True(t, slices.Contains(edges, Arc[string, int]{From: "D", To: "E", Weight: 3}))
+			assertx.True(t, slices.Contains(edges, Arc[string, int]{From: "B", To: "D", Weight: 5}))
+		})
+		t.Run(name+" disconnected graph yields spanning forest", func(t *testing.T) {
+			g := New[string]()
+			g.AddEdge("A", "B", 2)
+			g.AddEdge("B", "C", 3)
+			g.AddEdge("C", "A", 1)
+			g.AddEdge("X", "Y", -1)
+			g.AddNode("Z")
+			edges, total := spanningTree(g)
+			assertx.Equal(t, total, 2)
+			assertx.Equal(t, len(edges), 3)
+			assertx.True(t, slices.Contains(edges, Arc[string, int]{From: "X", To: "Y", Weight: -1}))
+		})
+	}
+	t.Run("undirected graph uses every edge once", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		g.AddEdge("A", "B", 3)
+		g.AddEdge("B", "C", 1)
+		g.AddEdge("C", "A", 2)
+		kruskalEdges, kruskalTotal := g.Kruskal()
+		primEdges, primTotal := g.Prim()
+		assertx.Equal(t, kruskalTotal, 3)
+		assertx.Equal(t, primTotal, 3)
+		assertx.Equal(t, len(kruskalEdges), 2)
+		assertx.Equal(t, len(primEdges), 2)
+	})
+	t.Run("kruskal and prim agree on total weight", func(t *testing.T) {
+		g, _, _ := createGridGraph(20)
+		kruskalEdges, kruskalTotal := g.Kruskal()
+		primEdges, primTotal := g.Prim()
+		assertx.Equal(t, kruskalTotal, primTotal)
+		assertx.Equal(t, len(kruskalEdges), g.Len()-1)
+		assertx.Equal(t, len(primEdges), g.Len()-1)
+	})
+}
+
+func BenchmarkKruskal_100(b *testing.B) {
+	g, _, _ := createGridGraph(100)
+	b.ResetTimer()
+	for b.Loop() {
+		g.Kruskal()
+	}
+}
+
+func BenchmarkPrim_100(b *testing.B) {
+	g, _, _ := createGridGraph(100)
+	b.ResetTimer()
+	for b.Loop() {
+		g.Prim()
+	}
+}
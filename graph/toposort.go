@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/salsgithub/godst/heap"
+)
+
+// TopologicalLayers uses Kahn's algorithm to group nodes so every node only depends on nodes in
+// earlier layers, nodes in the same layer can be processed in parallel and keep the order of Nodes.
+func (g *Graph[T, W]) TopologicalLayers() ([][]T, error) {
+	return g.TopologicalLayersFunc(nil)
+}
+
+// TopologicalLayersFunc behaves like TopologicalLayers with every layer sorted by cmp, a nil cmp keeps
+// the order of Nodes.
+func (g *Graph[T, W]) TopologicalLayersFunc(cmp func(a, b T) int) ([][]T, error) {
+	inDegree, err := g.kahnInDegree()
+	if err != nil {
+		return nil, err
+	}
+	nodes := g.Nodes()
+	if cmp == nil {
+		cmp = nodeOrderFunc(nodes)
+	}
+	layer := []T{}
+	for _, node := range nodes {
+		if inDegree[node] == 0 {
+			layer = append(layer, node)
+		}
+	}
+	layers := [][]T{}
+	processed := 0
+	for len(layer) > 0 {
+		slices.SortFunc(layer, cmp)
+		layers = append(layers, layer)
+		processed += len(layer)
+		next := []T{}
+		for _, node := range layer {
+			for _, edge := range g.adjacency[node] {
+				inDegree[edge.Link]--
+				if inDegree[edge.Link] == 0 {
+					next = append(next, edge.Link)
+				}
+			}
+		}
+		layer = next
+	}
+	if processed < g.Len() {
+		return nil, g.cycleError()
+	}
+	return layers, nil
+}
+
+// TopologicalSortFunc returns the smallest topological order under cmp by always taking the
+// smallest node whose dependencies are already placed, a nil cmp uses the order of Nodes.
+func (g *Graph[T, W]) TopologicalSortFunc(cmp func(a, b T) int) ([]T, error) {
+	inDegree, err := g.kahnInDegree()
+	if err != nil {
+		return nil, err
+	}
+	nodes := g.Nodes()
+	if cmp == nil {
+		cmp = nodeOrderFunc(nodes)
+	}
+	queue := heap.New(func(a, b T) bool {
+		return cmp(a, b) < 0
+	})
+	for _, node := range nodes {
+		if inDegree[node] == 0 {
+			queue.Push(node)
+		}
+	}
+	result := make([]T, 0, g.Len())
+	for !queue.IsEmpty() {
+		node, _ := queue.Pop()
+		result = append(result, node)
+		for _, edge := range g.adjacency[node] {
+			inDegree[edge.Link]--
+			if inDegree[edge.Link] == 0 {
+				queue.Push(edge.Link)
+			}
+		}
+	}
+	if len(result) < g.Len() {
+		return nil, g.cycleError()
+	}
+	return result, nil
+}
+
+// nodeOrderFunc compares nodes by their position in nodes.
+func nodeOrderFunc[T comparable](nodes []T) func(a, b T) int {
+	position := positions(nodes)
+	return func(a, b T) int {
+		return position[a] - position[b]
+	}
+}
+
+func (g *Graph[T, W]) kahnInDegree() (map[T]int, error) {
+	if g.undirected {
+		return nil, fmt.Errorf("%w: topological sort requires a directed graph", ErrUndirected)
+	}
+	inDegree := make(map[T]int, g.Len())
+	for _, edges := range g.adjacency {
+		for _, edge := range edges {
+			inDegree[edge.Link]++
+		}
+	}
+	return inDegree, nil
+}
+
+func (g *Graph[T, W]) cycleError() error {
+	cycle, _ := g.FindCycle()
+	return &CycleError[T]{Cycle: cycle}
+}
@@ -0,0 +1,143 @@
+package graph
+
+import (
+	"cmp"
+	"errors"
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func createBuildGraph() *Graph[string, int] {
+	g := New[string]()
+	g.AddEdge("fetch", "compile", 0)
+	g.AddEdge("generate", "compile", 0)
+	g.AddEdge("compile", "test", 0)
+	g.AddEdge("compile", "lint", 0)
+	g.AddEdge("test", "package", 0)
+	g.AddEdge("lint", "package", 0)
+	g.AddEdge("docs", "package", 0)
+	return g
+}
+
+func TestGraph_TopologicalLayers(t *testing.T) {
+	t.Run("empty graph has no layers", func(t *testing.T) {
+		g := New[string]()
+		layers, err := g.TopologicalLayers()
+		assertx.Nil(t, err)
+		assertx.Equal(t, layers, [][]string{})
+	})
+	t.Run("undirected graph yields error", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		layers, err := g.TopologicalLayers()
+		assertx.ErrorIs(t, err, ErrUndirected)
+		assertx.Nil(t, layers)
+	})
+	t.Run("cycle yields typed error", func(t *testing.T) {
+		g := createBuildGraph()
+		g.AddEdge("package", "fetch", 0)
+		layers, err := g.TopologicalLayers()
+		assertx.Nil(t, layers)
+		var cycleErr *CycleError[string]
+		assertx.True(t, errors.As(err, &cycleErr))
+		assertx.True(t, isCycle(g, cycleErr.Cycle))
+	})
+	t.Run("nodes are grouped by dependency depth", func(t *testing.T) {
+		layers, err := createBuildGraph().TopologicalLayers()
+		assertx.Nil(t, err)
+		assertx.Equal(t, layers, [][]string{
+			{"docs", "fetch", "generate"},
+			{"compile"},
+			{"lint", "test"},
+			{"package"},
+		})
+	})
+	t.Run("every layer keeps the order of nodes", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "D", 0)
+		g.AddEdge("B", "C", 0)
+		layers, err := g.TopologicalLayers()
+		assertx.Nil(t, err)
+		assertx.Equal(t, layers, [][]string{{"A", "B"}, {"C", "D"}})
+	})
+	t.Run("parallel edges count once per edge", func(t *testing.T) {
+		g := New[int]()
+		g.AddEdge(1, 2, 0)
+		g.AddEdge(1, 2, 0)
+		g.AddEdge(2, 3, 0)
+		layers, err := g.TopologicalLayers()
+		assertx.Nil(t, err)
+		assertx.Equal(t, layers, [][]int{{1}, {2}, {3}})
+	})
+}
+
+func TestGraph_TopologicalLayersFunc(t *testing.T) {
+	t.Run("layers are sorted by comparator", func(t *testing.T) {
+		layers, err := createBuildGraph().TopologicalLayersFunc(func(a, b string) int {
+			return cmp.Compare(b, a)
+		})
+		assertx.Nil(t, err)
+		assertx.Equal(t, layers, [][]string{
+			{"generate", "fetch", "docs"},
+			{"compile"},
+			{"test", "lint"},
+			{"package"},
+		})
+	})
+	t.Run("nil comparator keeps node order", func(t *testing.T) {
+		g := New[int64]()
+		g.AddEdge(3, 1, 0)
+		g.AddEdge(2, 1, 0)
+		layers, err := g.TopologicalLayersFunc(nil)
+		assertx.Nil(t, err)
+		assertx.Equal(t, layers, [][]int64{{3, 2}, {1}})
+	})
+}
+
+func TestGraph_TopologicalSortFunc(t *testing.T) {
+	t.Run("undirected graph yields error", func(t *testing.T) {
+		g := New[string](WithUndirected())
+		sorted, err := g.TopologicalSortFunc(cmp.Compare[string])
+		assertx.ErrorIs(t, err, ErrUndirected)
+		assertx.Nil(t, sorted)
+	})
+	t.Run("cycle yields typed error", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("A", "B", 0)
+		g.AddEdge("B", "A", 0)
+		sorted, err := g.TopologicalSortFunc(cmp.Compare[string])
+		assertx.Nil(t, sorted)
+		var cycleErr *CycleError[string]
+		assertx.True(t, errors.As(err, &cycleErr))
+	})
+	t.Run("nil comparator uses node order", func(t *testing.T) {
+		g := New[int64]()
+		g.AddEdge(3, 1, 0)
+		g.AddEdge(2, 4, 0)
+		g.AddEdge(1, 4, 0)
+		sorted, err := g.TopologicalSortFunc(nil)
+		assertx.Nil(t, err)
+		assertx.Equal(t, sorted, []int64{3, 1, 2, 4})
+	})
+	t.Run("lexicographically smallest order", func(t *testing.T) {
+		sorted, err := createBuildGraph().TopologicalSortFunc(cmp.Compare[string])
+		assertx.Nil(t, err)
+		assertx.Equal(t, sorted, []string{"docs", "fetch", "generate", "compile", "lint", "test", "package"})
+	})
+}
+
+func BenchmarkTopologicalSort_100(b *testing.B) {
+	g, _, _ := createGridGraph(100)
+	b.ResetTimer()
+	for b.Loop() {
+		g.TopologicalSort()
+	}
+}
+
+func BenchmarkTopologicalLayers_100(b *testing.B) {
+	g, _, _ := createGridGraph(100)
+	b.ResetTimer()
+	for b.Loop() {
+		g.TopologicalLayers()
+	}
+}
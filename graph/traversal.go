@@ -0,0 +1,128 @@
+package graph
+
+import "iter"
+
+// Visit describes a node reached by a traversal, the start node has no parent and a depth of zero.
+type Visit[T comparable] struct {
+	Node      T
+	Parent    T
+	HasParent bool
+	Depth     int
+}
+
+type traversalConfig[T comparable] struct {
+	maxDepth int
+	prune    func(visit Visit[T]) bool
+}
+
+type TraversalOption[T comparable] func(*traversalConfig[T])
+
+// WithMaxDepth stops the traversal from expanding nodes at the given depth.
+func WithMaxDepth[T comparable](depth int) TraversalOption[T] {
+	return func(c *traversalConfig[T]) {
+		if depth >= 0 {
+			c.maxDepth = depth
+		}
+	}
+}
+
+// WithPrune skips a node and the subtree below it whenever prune returns true for the visit reaching it.
+func WithPrune[T comparable](prune func(visit Visit[T]) bool) TraversalOption[T] {
+	return func(c *traversalConfig[T]) {
+		c.prune = prune
+	}
+}
+
+func newTraversalConfig[T comparable](options []TraversalOption[T]) *traversalConfig[T] {
+	c := &traversalConfig[T]{maxDepth: -1}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+func (c *traversalConfig[T]) pruned(visit Visit[T]) bool {
+	return c.prune != nil && c.prune(visit)
+}
+
+func (c *traversalConfig[T]) expands(visit Visit[T]) bool {
+	return c.maxDepth < 0 || visit.Depth < c.maxDepth
+}
+
+// BFSSeq lazily visits nodes in breadth first order, a missing start yields nothing.
+func (g *Graph[T, W]) BFSSeq(start T, options ...TraversalOption[T]) iter.Seq[Visit[T]] {
+	config := newTraversalConfig(options)
+	return func(yield func(Visit[T]) bool) {
+		root := Visit[T]{Node: start}
+		if _, ok := g.adjacency[start]; !ok || config.pruned(root) {
+			return
+		}
+		queue := []Visit[T]{root}
+		visited := map[T]bool{
+			start: true,
+		}
+		for len(queue) > 0 {
+			visit := queue[0]
+			queue = queue[1:]
+			if !yield(visit) {
+				return
+			}
+			if !config.expands(visit) {
+				continue
+			}
+			for _, edge := range g.adjacency[visit.Node] {
+				link := edge.Link
+				if visited[link] {
+					continue
+				}
+				next := Visit[T]{Node: link, Parent: visit.Node, HasParent: true, Depth: visit.Depth + 1}
+				if config.pruned(next) {
+					continue
+				}
+				visited[link] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+}
+
+// DFSSeq lazily visits nodes in depth first order, a missing start yields nothing. Every node is yielded
+// once, with a depth limit a node reached again through a shorter path is expanded again so nodes within
+// the limit are never missed.
+func (g *Graph[T, W]) DFSSeq(start T, options ...TraversalOption[T]) iter.Seq[Visit[T]] {
+	config := newTraversalConfig(options)
+	return func(yield func(Visit[T]) bool) {
+		if _, ok := g.adjacency[start]; !ok {
+			return
+		}
+		stack := []Visit[T]{{Node: start}}
+		depths := make(map[T]int)
+		reached := func(node T, depth int) bool {
+			shallowest, ok := depths[node]
+			return ok && (config.maxDepth < 0 || shallowest <= depth)
+		}
+		for len(stack) > 0 {
+			last := len(stack) - 1
+			visit := stack[last]
+			stack = stack[:last]
+			if reached(visit.Node, visit.Depth) || config.pruned(visit) {
+				continue
+			}
+			_, seen := depths[visit.Node]
+			depths[visit.Node] = visit.Depth
+			if !seen && !yield(visit) {
+				return
+			}
+			if !config.expands(visit) {
+				continue
+			}
+			neighbours := g.adjacency[visit.Node]
+			for i := len(neighbours) - 1; i >= 0; i-- {
+				link := neighbours[i].Link
+				if !reached(link, visit.Depth+1) {
+					stack = append(stack, Visit[T]{Node: link, Parent: visit.Node, HasParent: true, Depth: visit.Depth + 1})
+				}
+			}
+		}
+	}
+}
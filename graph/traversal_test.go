@@ -0,0 +1,153 @@
+package graph
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func createTraversalGraph() *Graph[string, int] {
+	g := New[string]()
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("A", "C", 1)
+	g.AddEdge("B", "D", 1)
+	g.AddEdge("B", "E", 1)
+	g.AddEdge("C", "F", 1)
+	g.AddEdge("E", "F", 1)
+	/*
+			A
+		   / \
+		  B   C
+		 / \   \
+		D   E - F
+	*/
+	return g
+}
+
+func collectVisits[T comparable](seq iter.Seq[Visit[T]]) []Visit[T] {
+	visits := []Visit[T]{}
+	for visit := range seq {
+		visits = append(visits, visit)
+	}
+	return visits
+}
+
+func TestGraph_BFSSeq(t *testing.T) {
+	t.Run("missing start yields nothing", func(t *testing.T) {
+		g := New[string]()
+		assertx.Equal(t, collectVisits(g.BFSSeq("A")), []Visit[string]{})
+	})
+	t.Run("visits carry parent and depth", func(t *testing.T) {
+		g := createTraversalGraph()
+		assertx.Equal(t, collectVisits(g.BFSSeq("A")), []Visit[string]{
+			{Node: "A"},
+			{Node: "B", Parent: "A", HasParent: true, Depth: 1},
+			{Node: "C", Parent: "A", HasParent: true, Depth: 1},
+			{Node: "D", Parent: "B", HasParent: true, Depth: 2},
+			{Node: "E", Parent: "B", HasParent: true, Depth: 2},
+			{Node: "F", Parent: "C", HasParent: true, Depth: 2},
+		})
+	})
+	t.Run("breaking stops the traversal", func(t *testing.T) {
+		g := createTraversalGraph()
+		order := []string{}
+		for visit := range g.BFSSeq("A") {
+			order = append(order, visit.Node)
+			if visit.Node == "C" {
+				break
+			}
+		}
+		assertx.Equal(t, order, []string{"A", "B", "C"})
+	})
+	t.Run("max depth limits expansion", func(t *testing.T) {
+		g := createTraversalGraph()
+		order := []string{}
+		for visit := range g.BFSSeq("A", WithMaxDepth[string](1)) {
+			order = append(order, visit.Node)
+		}
+		assertx.Equal(t, order, []string{"A", "B", "C"})
+	})
+	t.Run("pruning skips subtrees", func(t *testing.T) {
+		g := createTraversalGraph()
+		order := []string{}
+		prune := WithPrune(func(visit Visit[string]) bool {
+			return visit.Node == "B"
+		})
+		for visit := range g.BFSSeq("A", prune) {
+			order = append(order, visit.Node)
+		}
+		assertx.Equal(t, order, []string{"A", "C", "F"})
+	})
+	t.Run("pruning the start yields nothing", func(t *testing.T) {
+		g := createTraversalGraph()
+		prune := WithPrune(func(visit Visit[string]) bool {
+			return !visit.HasParent
+		})
+		assertx.Equal(t, collectVisits(g.BFSSeq("A", prune)), []Visit[string]{})
+	})
+}
+
+func TestGraph_DFSSeq(t *testing.T) {
+	t.Run("missing start yields nothing", func(t *testing.T) {
+		g := New[string]()
+		assertx.Equal(t, collectVisits(g.DFSSeq("A")), []Visit[string]{})
+	})
+	t.Run("visits carry parent and depth", func(t *testing.T) {
+		g := createTraversalGraph()
+		assertx.Equal(t, collectVisits(g.DFSSeq("A")), []Visit[string]{
+			{Node: "A"},
+			{Node: "B", Parent: "A", HasParent: true, Depth: 1},
+			{Node: "D", Parent: "B", HasParent: true, Depth: 2},
+			{Node: "E", Parent: "B", HasParent: true, Depth: 2},
+			{Node: "F", Parent: "E", HasParent: true, Depth: 3},
+			{Node: "C", Parent: "A", HasParent: true, Depth: 1},
+		})
+	})
+	t.Run("breaking stops the traversal", func(t *testing.T) {
+		g := createTraversalGraph()
+		order := []string{}
+		for visit := range g.DFSSeq("A") {
+			order = append(order, visit.Node)
+			if visit.Node == "D" {
+				break
+			}
+		}
+		assertx.Equal(t, order, []string{"A", "B", "D"})
+	})
+	t.Run("max depth limits expansion", func(t *testing.T) {
+		g := createTraversalGraph()
+		order := []string{}
+		for visit := range g.DFSSeq("A", WithMaxDepth[string](2), WithMaxDepth[string](-1)) {
+			order = append(order, visit.Node)
+		}
+		assertx.Equal(t, order, []string{"A", "B", "D", "E", "C", "F"})
+	})
+	t.Run("max depth follows shorter paths into visited nodes", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "c", 1)
+		g.AddEdge("c", "d", 1)
+		g.AddEdge("a", "c", 1)
+		visits := collectVisits(g.DFSSeq("a", WithMaxDepth[string](2)))
+		assertx.Equal(t, visits, []Visit[string]{
+			{Node: "a"},
+			{Node: "b", Parent: "a", HasParent: true, Depth: 1},
+			{Node: "c", Parent: "b", HasParent: true, Depth: 2},
+			{Node: "d", Parent: "c", HasParent: true, Depth: 2},
+		})
+		visits = collectVisits(g.DFSSeq("a", WithMaxDepth[string](1)))
+		assertx.Equal(t, len(visits), 3)
+	})
+	t.Run("pruning skips subtrees", func(t *testing.T) {
+		g := createTraversalGraph()
+		order := []string{}
+		prune := WithPrune(func(visit Visit[string]) bool {
+			return visit.Node == "E"
+		})
+		for visit := range g.DFSSeq("A", prune) {
+			order = append(order, visit.Node)
+		}
+		assertx.Equal(t, order, []string{"A", "B", "D", "C", "F"})
+	})
+}
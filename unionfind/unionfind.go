@@ -0,0 +1,80 @@
+package unionfind
+
+type UnionFind[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	sets   int
+}
+
+func New[T comparable](values ...T) *UnionFind[T] {
+	u := &UnionFind[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+	}
+	u.Add(values...)
+	return u
+}
+
+func (u *UnionFind[T]) Add(values ...T) {
+	for _, value := range values {
+		if _, ok := u.parent[value]; ok {
+			continue
+		}
+		u.parent[value] = value
+		u.sets++
+	}
+}
+
+func (u *UnionFind[T]) Find(value T) (T, bool) {
+	if _, ok := u.parent[value]; !ok {
+		var zero T
+		return zero, false
+	}
+	root := value
+	for u.parent[root] != root {
+		root = u.parent[root]
+	}
+	for value != root {
+		next := u.parent[value]
+		u.parent[value] = root
+		value = next
+	}
+	return root, true
+}
+
+func (u *UnionFind[T]) Union(a, b T) bool {
+	u.Add(a, b)
+	rootA, _ := u.Find(a)
+	rootB, _ := u.Find(b)
+	if rootA == rootB {
+		return false
+	}
+	switch {
+	case u.rank[rootA] < u.rank[rootB]:
+		u.parent[rootA] = rootB
+	case u.rank[rootA] > u.rank[rootB]:
+		u.parent[rootB] = rootA
+	default:
+		u.parent[rootB] = rootA
+		u.rank[rootA]++
+	}
+	u.sets--
+	return true
+}
+
+func (u *UnionFind[T]) Connected(a, b T) bool {
+	rootA, ok := u.Find(a)
+	if !ok {
+		return false
+	}
+	rootB, ok := u.Find(b)
+	return ok && rootA == rootB
+}
+
+func (u *UnionFind[T]) Sets() int {
+	return u.sets
+}
+
+func (u *UnionFind[T]) Len() int {
+	return len(u.parent)
+}
@@ -0,0 +1,80 @@
+package unionfind
+
+import (
+	"testing"
+
+	"github.com/salsgithub/godst/assertx"
+)
+
+func TestUnionFind_New(t *testing.T) {
+	u := New(1, 2, 3, 3)
+	assertx.NotNil(t, u)
+	assertx.Equal(t, u.Len(), 3)
+	assertx.Equal(t, u.Sets(), 3)
+}
+
+func TestUnionFind_Find(t *testing.T) {
+	t.Run("find on missing value yields zero value", func(t *testing.T) {
+		u := New[string]()
+		root, ok := u.Find("Go")
+		assertx.False(t, ok)
+		assertx.Equal(t, root, "")
+	})
+	t.Run("value is its own root until joined", func(t *testing.T) {
+		u := New("Go")
+		root, ok := u.Find("Go")
+		assertx.True(t, ok)
+		assertx.Equal(t, root, "Go")
+	})
+}
+
+func TestUnionFind_Union(t *testing.T) {
+	t.Run("union adds missing values", func(t *testing.T) {
+		u := New[string]()
+		assertx.True(t, u.Union("Go", "Rust"))
+		assertx.Equal(t, u.Len(), 2)
+		assertx.Equal(t, u.Sets(), 1)
+	})
+	t.Run("union of joined values does nothing", func(t *testing.T) {
+		u := New(1, 2, 3)
+		assertx.True(t, u.Union(1, 2))
+		assertx.True(t, u.Union(2, 3))
+		assertx.False(t, u.Union(1, 3))
+		assertx.Equal(t, u.Sets(), 1)
+	})
+	t.Run("unions share a root", func(t *testing.T) {
+		u := New(1, 2, 3, 4, 5, 6)
+		u.Union(1, 2)
+		u.Union(3, 4)
+		u.Union(4, 5)
+		u.Union(2, 5)
+		root, _ := u.Find(1)
+		for _, value := range []int{2, 3, 4, 5} {
+			other, _ := u.Find(value)
+			assertx.Equal(t, other, root)
+		}
+		other, _ := u.Find(6)
+		assertx.NotEqual(t, other, root)
+		assertx.Equal(t, u.Sets(), 2)
+	})
+}
+
+func TestUnionFind_Connected(t *testing.T) {
+	u := New(1, 2, 3)
+	u.Union(1, 2)
+	assertx.True(t, u.Connected(1, 2))
+	assertx.False(t, u.Connected(1, 3))
+	assertx.False(t, u.Connected(1, 4))
+	assertx.False(t, u.Connected(4, 1))
+}
+
+func BenchmarkUnionFind_Union(b *testing.B) {
+	size := 100_000
+	for b.Loop() {
+		u := New[int]()
+		for i := 1; i < size; i++ {
+			u.Union(i-1, i)
+		}
+		u.Find(0)
+	}
+}